@@ -119,11 +119,29 @@ func assetCommands(rt *handlers.Runtime, id string) []*cobra.Command {
 		{Name: "edit", Group: id, Run: h.EditCommands, Descriptor: "asset"},
 		{Name: "import", Group: id, Run: h.ImportCommands, Descriptor: "asset"},
 		{Name: "export", Group: id, Run: h.ExportCommands, Descriptor: "asset"},
+		{Name: "render", Group: id, Run: h.RenderCommands, Descriptor: "asset"},
+		{Name: "backup", Group: id, Run: h.BackupCommands, Descriptor: "asset"},
+		{Name: "run", Group: id, Run: h.RunCommands, Descriptor: "asset"},
+		{Name: "add", Group: id, Run: h.AddCommands, Descriptor: "asset"},
+		{Name: "remove", Group: id, Run: h.RemoveCommands, Descriptor: "asset"},
+		{Name: "set", Group: id, Run: h.SetCommands, Descriptor: "asset"},
+		{Name: "attach", Group: id, Run: h.AttachCommands, Descriptor: "asset"},
+		{Name: "sync", Group: id, Run: h.SyncCommands, Descriptor: "asset"},
+		{Name: "test", Group: id, Run: h.TestCommands, Descriptor: "asset"},
+		{Name: "lint", Group: id, Run: h.LintCommands, Descriptor: "asset"},
+		{Name: "rename", Group: id, Run: h.RenameCommands, Descriptor: "asset"},
+		{Name: "refactor", Group: id, Run: h.RefactorCommands, Descriptor: "asset"},
+		{Name: "tag", Group: id, Run: h.TagCommands, Descriptor: "asset"},
+		{Name: "migrate", Group: id, Run: h.MigrateCommands, Descriptor: "asset"},
 	})
 	if err != nil {
 		logging.Error(err, "failed to create asset commands")
 		return nil
 	}
+	if cmd := handlers.NewApplyHandler(rt).Command(); cmd != nil {
+		cmd.GroupID = id
+		commands = append(commands, cmd)
+	}
 	return commands
 }
 
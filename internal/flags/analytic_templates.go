@@ -23,3 +23,21 @@ type AnalyticTemplateCreateOptions struct {
 func (o *AnalyticTemplateCreateOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.Replace, "replace", o.Replace, "Replace the exist command template if it exists")
 }
+
+type AnalyticTemplateTestOptions struct {
+	Pre   string
+	Post  string
+	Dir   string
+	JUnit string
+}
+
+func (o *AnalyticTemplateTestOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Pre, "pre", o.Pre, "Captured pre command output inline or as @path")
+	cmd.Flags().StringVar(&o.Post, "post", o.Post, "Captured post command output inline or as @path")
+	cmd.Flags().StringVar(&o.Dir, "dir", o.Dir, "Directory of captured <case>.pre.* and <case>.post.* output files to test")
+	cmd.Flags().StringVar(&o.JUnit, "junit", o.JUnit, "Write the results as a JUnit XML report to this file")
+	cmd.MarkFlagsRequiredTogether("pre", "post")
+	cmd.MarkFlagsMutuallyExclusive("pre", "dir")
+	cmd.MarkFlagsMutuallyExclusive("post", "dir")
+	cmd.MarkFlagsOneRequired("pre", "dir")
+}
@@ -15,3 +15,7 @@ func TestAnalyticTemplatesGetOptions(t *testing.T) {
 func TestAnalyticTemplatesCreateOptions(t *testing.T) {
 	checkFlags(t, &AnalyticTemplateCreateOptions{}, []string{"replace"})
 }
+
+func TestAnalyticTemplateTestOptions(t *testing.T) {
+	checkFlags(t, &AnalyticTemplateTestOptions{}, []string{"pre", "post", "dir", "junit"})
+}
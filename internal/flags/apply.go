@@ -0,0 +1,19 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "github.com/spf13/cobra"
+
+// Command line options for `apply ...`
+type ApplyOptions struct {
+	Filename []string
+	DryRun   bool
+}
+
+func (o *ApplyOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&o.Filename, "filename", "f", o.Filename, "Manifest file or directory of manifests to apply")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Display the changes without updating the server")
+	cmd.MarkFlagRequired("filename")
+}
@@ -30,6 +30,12 @@ type Committer interface {
 	GetPath() string
 }
 
+// Canonicalizer is implemented by the common options of commands that can
+// write assets in canonical form.
+type Canonicalizer interface {
+	GetCanonical() bool
+}
+
 // ParseParams parses a slice of "key=value" strings into a map of query parameters.
 // Each element in the params slice must be in the format "key=value".
 //
@@ -110,6 +116,7 @@ type AssetExportCommon struct {
 	PrivateKeyFile string
 	Message        string
 	Params         []string
+	Canonical      bool
 }
 
 func (o *AssetExportCommon) Flags(cmd *cobra.Command) {
@@ -119,6 +126,7 @@ func (o *AssetExportCommon) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.PrivateKeyFile, "private-key-file", o.PrivateKeyFile, "Path to Git private key")
 	cmd.Flags().StringVar(&o.Message, "message", o.Message, "Git commit message")
 	cmd.Flags().StringArrayVar(&o.Params, "params", o.Params, "Query parameters in key=value format (can be specified multiple times)")
+	cmd.Flags().BoolVar(&o.Canonical, "canonical", o.Canonical, "Strip server managed fields and write assets in a stable format")
 }
 
 func (o *AssetExportCommon) GetPath() string {
@@ -149,6 +157,10 @@ func (o *AssetExportCommon) ParseParams() (map[string]string, error) {
 	return ParseParams(o.Params)
 }
 
+func (o *AssetExportCommon) GetCanonical() bool {
+	return o.Canonical
+}
+
 type AssetDumpCommon struct {
 	Path           string
 	Repository     string
@@ -156,6 +168,7 @@ type AssetDumpCommon struct {
 	PrivateKeyFile string
 	Message        string
 	Params         []string
+	Canonical      bool
 }
 
 func (o *AssetDumpCommon) Flags(cmd *cobra.Command) {
@@ -165,6 +178,7 @@ func (o *AssetDumpCommon) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.PrivateKeyFile, "private-key-file", o.PrivateKeyFile, "Path to Git private key")
 	cmd.Flags().StringVar(&o.Message, "message", o.Message, "Git commit message")
 	cmd.Flags().StringArrayVar(&o.Params, "params", o.Params, "Query parameters in key=value format (can be specified multiple times)")
+	cmd.Flags().BoolVar(&o.Canonical, "canonical", o.Canonical, "Strip server managed fields and write assets in a stable format")
 }
 
 func (o *AssetDumpCommon) GetPath() string {
@@ -195,6 +209,10 @@ func (o *AssetDumpCommon) ParseParams() (map[string]string, error) {
 	return ParseParams(o.Params)
 }
 
+func (o *AssetDumpCommon) GetCanonical() bool {
+	return o.Canonical
+}
+
 type AssetLoadCommon struct {
 	Repository     string
 	Reference      string
@@ -254,3 +272,11 @@ func (o *AssetCopyCommon) GetParams() []string {
 func (o *AssetCopyCommon) ParseParams() (map[string]string, error) {
 	return ParseParams(o.Params)
 }
+
+type AssetRenameCommon struct {
+	DryRun bool
+}
+
+func (o *AssetRenameCommon) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Display the changes without updating the server")
+}
@@ -16,11 +16,11 @@ func TestAssetImportCommon(t *testing.T) {
 }
 
 func TestAssetExportCommon(t *testing.T) {
-	checkFlags(t, &AssetExportCommon{}, []string{"path", "repository", "reference", "private-key-file", "message", "params"})
+	checkFlags(t, &AssetExportCommon{}, []string{"path", "repository", "reference", "private-key-file", "message", "params", "canonical"})
 }
 
 func TestAssetDumpCommon(t *testing.T) {
-	checkFlags(t, &AssetDumpCommon{}, []string{"path", "repository", "reference", "private-key-file", "message", "params"})
+	checkFlags(t, &AssetDumpCommon{}, []string{"path", "repository", "reference", "private-key-file", "message", "params", "canonical"})
 }
 
 func TestAssetLoadCommon(t *testing.T) {
@@ -31,6 +31,10 @@ func TestAssetCopyCommon(t *testing.T) {
 	checkFlags(t, &AssetCopyCommon{}, []string{"to", "from", "replace", "params"})
 }
 
+func TestAssetRenameCommon(t *testing.T) {
+	checkFlags(t, &AssetRenameCommon{}, []string{"dry-run"})
+}
+
 func TestAssetImportCommonGetters(t *testing.T) {
 	common := &AssetImportCommon{
 		Repository:     "https://github.com/example/repo",
@@ -17,3 +17,21 @@ func (o *AutomationCreateOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.Description, "description", o.Description, "Description of the automation")
 	cmd.Flags().BoolVar(&o.Replace, "replace", o.Replace, "Replace the exist automation if it exists")
 }
+
+type AutomationCopyOptions struct {
+	WithDependencies bool
+}
+
+func (o *AutomationCopyOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.WithDependencies, "with-dependencies", o.WithDependencies, "Copy all assets the automation depends on")
+}
+
+type AutomationExportOptions struct {
+	WithDependencies bool
+	Expand           bool
+}
+
+func (o *AutomationExportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.WithDependencies, "with-dependencies", o.WithDependencies, "Export all assets the automation depends on along with a manifest")
+	cmd.Flags().BoolVar(&o.Expand, "expand", o.Expand, "Write each trigger to a separate file")
+}
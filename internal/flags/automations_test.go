@@ -9,3 +9,11 @@ import "testing"
 func TestAutomationCreateOptions(t *testing.T) {
 	checkFlags(t, &AutomationCreateOptions{}, []string{"description", "replace"})
 }
+
+func TestAutomationCopyOptions(t *testing.T) {
+	checkFlags(t, &AutomationCopyOptions{}, []string{"with-dependencies"})
+}
+
+func TestAutomationExportOptions(t *testing.T) {
+	checkFlags(t, &AutomationExportOptions{}, []string{"with-dependencies", "expand"})
+}
@@ -15,3 +15,17 @@ type CommandTemplateGetOptions struct {
 func (o *CommandTemplateGetOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Include all command templates")
 }
+
+type CommandTemplateRunOptions struct {
+	Devices []string
+	Group   string
+	Vars    string
+}
+
+func (o *CommandTemplateRunOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&o.Devices, "device", o.Devices, "Device to run the command template against (can be specified multiple times)")
+	cmd.Flags().StringVar(&o.Group, "group", o.Group, "Run the command template against all devices in this device group")
+	cmd.Flags().StringVar(&o.Vars, "vars", o.Vars, "Template variables as a JSON string or @path to a JSON or YAML file")
+	cmd.MarkFlagsMutuallyExclusive("device", "group")
+	cmd.MarkFlagsOneRequired("device", "group")
+}
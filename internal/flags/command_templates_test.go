@@ -9,3 +9,7 @@ import "testing"
 func TestCommandTemplateGetOptions(t *testing.T) {
 	checkFlags(t, &CommandTemplateGetOptions{}, []string{"all"})
 }
+
+func TestCommandTemplateRunOptions(t *testing.T) {
+	checkFlags(t, &CommandTemplateRunOptions{}, []string{"device", "group", "vars"})
+}
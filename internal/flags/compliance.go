@@ -0,0 +1,33 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type ComplianceRunOptions struct {
+	Tree         string
+	Node         string
+	Version      string
+	Devices      []string
+	Group        string
+	Report       string
+	ReportFormat string
+	Timeout      int
+}
+
+func (o *ComplianceRunOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Tree, "tree", o.Tree, "Name of the golden configuration tree (required)")
+	cmd.Flags().StringVar(&o.Node, "node", "base", "Path of the tree node to check")
+	cmd.Flags().StringVar(&o.Version, "version", o.Version, "Tree version to check (defaults to the latest version)")
+	cmd.Flags().StringSliceVar(&o.Devices, "devices", o.Devices, "Comma separated list of devices to check")
+	cmd.Flags().StringVar(&o.Group, "group", o.Group, "Check all devices that are members of this device group")
+	cmd.Flags().StringVar(&o.Report, "report", o.Report, "Write the compliance report to this file")
+	cmd.Flags().StringVar(&o.ReportFormat, "report-format", "json", "Format of the report file (json, csv, junit)")
+	cmd.Flags().IntVar(&o.Timeout, "timeout", 300, "Number of seconds to wait for the compliance check to complete")
+	cmd.MarkFlagRequired("tree")
+	cmd.MarkFlagsMutuallyExclusive("devices", "group")
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type ConfigTemplateRenderOptions struct {
+	Vars   string
+	Device string
+}
+
+func (o *ConfigTemplateRenderOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Vars, "vars", o.Vars, "Template variables as a JSON string or @path to a JSON or YAML file")
+	cmd.Flags().StringVar(&o.Device, "device", o.Device, "Show a diff of the rendered template against the device configuration")
+}
+
+type ConfigTemplateCreateOptions struct {
+	Template string
+	Replace  bool
+}
+
+func (o *ConfigTemplateCreateOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Template, "template", o.Template, "Template text or @path to a file containing the template")
+	cmd.Flags().BoolVar(&o.Replace, "replace", o.Replace, "Replace the existing config template if it exists")
+}
@@ -0,0 +1,15 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "testing"
+
+func TestConfigTemplateRenderOptions(t *testing.T) {
+	checkFlags(t, &ConfigTemplateRenderOptions{}, []string{"vars", "device"})
+}
+
+func TestConfigTemplateCreateOptions(t *testing.T) {
+	checkFlags(t, &ConfigTemplateCreateOptions{}, []string{"template", "replace"})
+}
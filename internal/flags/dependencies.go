@@ -0,0 +1,17 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type DependencyGetOptions struct {
+	To string
+}
+
+func (o *DependencyGetOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.To, "to", o.To, "Report which dependencies are missing on this profile")
+}
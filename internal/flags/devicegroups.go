@@ -15,3 +15,14 @@ type DeviceGroupCreateOptions struct {
 func (o *DeviceGroupCreateOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.Description, "description", o.Description, "Short description of model")
 }
+
+type DeviceGroupSyncOptions struct {
+	Match  []string
+	DryRun bool
+}
+
+func (o *DeviceGroupSyncOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&o.Match, "match", o.Match, "Device match expression such as 'name=~^nyc-' (can be specified multiple times)")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Display the changes without updating the device group")
+	cmd.MarkFlagRequired("match")
+}
@@ -9,3 +9,7 @@ import "testing"
 func TestDeviceGroupCreateOptions(t *testing.T) {
 	checkFlags(t, &DeviceGroupCreateOptions{}, []string{"description"})
 }
+
+func TestDeviceGroupSyncOptions(t *testing.T) {
+	checkFlags(t, &DeviceGroupSyncOptions{}, []string{"match", "dry-run"})
+}
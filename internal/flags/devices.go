@@ -0,0 +1,50 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type DeviceCreateOptions struct {
+	Host        string
+	OsType      string
+	Adapter     string
+	Credentials string
+}
+
+func (o *DeviceCreateOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Host, "host", o.Host, "Hostname or IP address of the device (required)")
+	cmd.Flags().StringVar(&o.OsType, "ostype", o.OsType, "Operating system type of the device (required)")
+	cmd.Flags().StringVar(&o.Adapter, "adapter", o.Adapter, "Name of the adapter that manages the device (required)")
+	cmd.Flags().StringVar(&o.Credentials, "credentials", o.Credentials, "Name of the credentials used to connect to the device")
+	cmd.MarkFlagRequired("host")
+	cmd.MarkFlagRequired("ostype")
+	cmd.MarkFlagRequired("adapter")
+}
+
+type DeviceDeleteOptions struct {
+	Adapter string
+}
+
+func (o *DeviceDeleteOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Adapter, "adapter", o.Adapter, "Name of the adapter that manages the device (defaults to the device origin)")
+}
+
+type DeviceImportOptions struct {
+	DryRun bool
+}
+
+func (o *DeviceImportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Validate the inventory and report the changes without making them")
+}
+
+type DeviceBackupOptions struct {
+	Group string
+}
+
+func (o *DeviceBackupOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Group, "group", o.Group, "Only back up devices that are members of this device group")
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "testing"
+
+func TestDeviceCreateOptions(t *testing.T) {
+	checkFlags(t, &DeviceCreateOptions{}, []string{"host", "ostype", "adapter", "credentials"})
+}
+
+func TestDeviceDeleteOptions(t *testing.T) {
+	checkFlags(t, &DeviceDeleteOptions{}, []string{"adapter"})
+}
+
+func TestDeviceImportOptions(t *testing.T) {
+	checkFlags(t, &DeviceImportOptions{}, []string{"dry-run"})
+}
+
+func TestDeviceBackupOptions(t *testing.T) {
+	checkFlags(t, &DeviceBackupOptions{}, []string{"group"})
+}
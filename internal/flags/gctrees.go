@@ -0,0 +1,39 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type GoldenConfigNodeOptions struct {
+	Version string
+}
+
+func (o *GoldenConfigNodeOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Version, "version", o.Version, "Tree version to update (defaults to the latest version)")
+}
+
+type GoldenConfigVariablesOptions struct {
+	Version string
+	Vars    string
+}
+
+func (o *GoldenConfigVariablesOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Version, "version", o.Version, "Tree version to update (defaults to the latest version)")
+	cmd.Flags().StringVar(&o.Vars, "vars", o.Vars, "Node variables as a JSON string or @path to a JSON or YAML file")
+	cmd.MarkFlagRequired("vars")
+}
+
+type GoldenConfigDevicesOptions struct {
+	Version string
+	Devices []string
+}
+
+func (o *GoldenConfigDevicesOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Version, "version", o.Version, "Tree version to update (defaults to the latest version)")
+	cmd.Flags().StringArrayVar(&o.Devices, "device", o.Devices, "Device to attach to the node (can be specified multiple times)")
+	cmd.MarkFlagRequired("device")
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "testing"
+
+func TestGoldenConfigNodeOptions(t *testing.T) {
+	checkFlags(t, &GoldenConfigNodeOptions{}, []string{"version"})
+}
+
+func TestGoldenConfigVariablesOptions(t *testing.T) {
+	checkFlags(t, &GoldenConfigVariablesOptions{}, []string{"version", "vars"})
+}
+
+func TestGoldenConfigDevicesOptions(t *testing.T) {
+	checkFlags(t, &GoldenConfigDevicesOptions{}, []string{"version", "device"})
+}
@@ -25,3 +25,11 @@ type JsonFormGetOptions struct {
 func (o *JsonFormGetOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Include all JSON Forms")
 }
+
+type JsonFormExportOptions struct {
+	Expand bool
+}
+
+func (o *JsonFormExportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Expand, "expand", o.Expand, "Write the schema, UI schema and bindings to separate files")
+}
@@ -13,3 +13,7 @@ func TestJsonFormCreateOptions(t *testing.T) {
 func TestJsonFormGetOptions(t *testing.T) {
 	checkFlags(t, &JsonFormGetOptions{}, []string{"all"})
 }
+
+func TestJsonFormExportOptions(t *testing.T) {
+	checkFlags(t, &JsonFormExportOptions{}, []string{"expand"})
+}
@@ -0,0 +1,22 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "github.com/spf13/cobra"
+
+// Command line options for `migrate assets ...`
+type MigrateAssetsOptions struct {
+	FromVersion string
+	ToVersion   string
+	DryRun      bool
+}
+
+func (o *MigrateAssetsOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.FromVersion, "from-version", o.FromVersion, "Platform version the assets were exported from")
+	cmd.Flags().StringVar(&o.ToVersion, "to-version", o.ToVersion, "Platform version to migrate the assets to")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Display the changes without updating the files")
+	cmd.MarkFlagRequired("from-version")
+	cmd.MarkFlagRequired("to-version")
+}
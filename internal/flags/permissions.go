@@ -0,0 +1,23 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type PermissionSetOptions struct {
+	Read         []string
+	Write        []string
+	AllInProject string
+	Selector     string
+}
+
+func (o *PermissionSetOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&o.Read, "read", o.Read, "Comma separated list of groups that can read the asset")
+	cmd.Flags().StringSliceVar(&o.Write, "write", o.Write, "Comma separated list of groups that can modify the asset")
+	cmd.Flags().StringVar(&o.AllInProject, "all-in-project", o.AllInProject, "Update every asset of the kind in the named project")
+	cmd.Flags().StringVar(&o.Selector, "selector", o.Selector, "Update every asset of the kind with a name matching the glob pattern")
+}
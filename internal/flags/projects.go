@@ -8,6 +8,19 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Command line options for `create project ...`
+type ProjectCreateOptions struct {
+	FromAssets          []string
+	IncludeDependencies bool
+	DeleteOriginals     bool
+}
+
+func (o *ProjectCreateOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&o.FromAssets, "from-assets", o.FromAssets, "Comma separated list of <kind>:<name> assets to add to the project")
+	cmd.Flags().BoolVar(&o.IncludeDependencies, "include-dependencies", o.IncludeDependencies, "Add the assets the selected assets depend on to the project")
+	cmd.Flags().BoolVar(&o.DeleteOriginals, "delete-originals", o.DeleteOriginals, "Delete the original assets after the project is created")
+}
+
 // Command line options for `import project ...`
 type ProjectImportOptions struct {
 	Members                 []string
@@ -40,3 +53,13 @@ type ProjectCopyOptions struct {
 func (o *ProjectCopyOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringArrayVar(&o.Members, "member", o.Members, "Configure one or more project members")
 }
+
+// Command line options for `set project-members ...`
+type ProjectMemberSetOptions struct {
+	FromFile string
+}
+
+func (o *ProjectMemberSetOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.FromFile, "from-file", o.FromFile, "Path to a JSON or YAML file with the list of project members")
+	cmd.MarkFlagRequired("from-file")
+}
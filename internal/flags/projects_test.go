@@ -22,3 +22,11 @@ func TestProjectExportOptions(t *testing.T) {
 func TestProjectCopyOptions(t *testing.T) {
 	checkFlags(t, &ProjectCopyOptions{}, []string{"member"})
 }
+
+func TestProjectCreateOptions(t *testing.T) {
+	checkFlags(t, &ProjectCreateOptions{}, []string{"from-assets", "include-dependencies", "delete-originals"})
+}
+
+func TestProjectMemberSetOptions(t *testing.T) {
+	checkFlags(t, &ProjectMemberSetOptions{}, []string{"from-file"})
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type ReplaceAppOptions struct {
+	From      string
+	To        string
+	MethodMap string
+	Scope     string
+	DryRun    bool
+	BackupDir string
+}
+
+func (o *ReplaceAppOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.From, "from", o.From, "Name of the adapter or application to replace")
+	cmd.Flags().StringVar(&o.To, "to", o.To, "Name of the adapter or application to use instead")
+	cmd.Flags().StringVar(&o.MethodMap, "method-map", o.MethodMap, "Map of old method names to new method names, inline or @file")
+	cmd.Flags().StringVar(&o.Scope, "scope", o.Scope, "Limit the workflows that are changed, for example project:<name>")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "Display the changes without updating the server")
+	cmd.Flags().StringVar(&o.BackupDir, "backup-dir", o.BackupDir, "Directory to write the original workflows to before updating them")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+}
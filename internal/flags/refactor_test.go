@@ -0,0 +1,11 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package flags
+
+import "testing"
+
+func TestReplaceAppOptions(t *testing.T) {
+	checkFlags(t, &ReplaceAppOptions{}, []string{"from", "to", "method-map", "scope", "dry-run", "backup-dir"})
+}
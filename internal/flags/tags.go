@@ -13,3 +13,13 @@ type TagCreateOptions struct {
 func (o *TagCreateOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.Description, "description", o.Description, "Description of this tag")
 }
+
+type AssetTagOptions struct {
+	Add    []string
+	Remove []string
+}
+
+func (o *AssetTagOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&o.Add, "add", o.Add, "Comma separated list of tags to add to the asset")
+	cmd.Flags().StringSliceVar(&o.Remove, "remove", o.Remove, "Comma separated list of tags to remove from the asset")
+}
@@ -9,3 +9,7 @@ import "testing"
 func TestTagCreateOptions(t *testing.T) {
 	checkFlags(t, &TagCreateOptions{}, []string{"description"})
 }
+
+func TestAssetTagOptions(t *testing.T) {
+	checkFlags(t, &AssetTagOptions{}, []string{"add", "remove"})
+}
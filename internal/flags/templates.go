@@ -26,10 +26,12 @@ func (o *TemplateCreateOptions) Flags(cmd *cobra.Command) {
 
 type TemplateGetOptions struct {
 	All bool
+	Tag string
 }
 
 func (o *TemplateGetOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Include all workflows")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Only include templates with the tag")
 }
 
 type TemplateLoadOptions struct {
@@ -41,3 +43,42 @@ func (o *TemplateLoadOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.Type, "type", o.Type, "Type of template to load (valid values are textfsm, native)")
 	cmd.Flags().StringVar(&o.Group, "group", o.Group, "Group to load templates into (only valid with --type=textfsm)")
 }
+
+type TemplateTestOptions struct {
+	Input  string
+	Expect string
+	Type   string
+}
+
+func (o *TemplateTestOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Input, "input", o.Input, "Sample command output (textfsm) or variables (jinja2) inline or as @path (REQUIRED)")
+	cmd.MarkFlagRequired("input")
+	cmd.Flags().StringVar(&o.Expect, "expect", o.Expect, "Compare the result against the expected output in @path")
+	cmd.Flags().StringVar(&o.Type, "type", o.Type, "Type of a local template file (valid values are textfsm, jinja2)")
+}
+
+type TemplateExportOptions struct {
+	Expand bool
+	Tag    string
+}
+
+func (o *TemplateExportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Expand, "expand", o.Expand, "Write the template body to a separate .j2 or .textfsm file")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Export every template with the tag instead of a single template")
+}
+
+type TemplateCopyOptions struct {
+	Tag string
+}
+
+func (o *TemplateCopyOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Copy every template with the tag instead of a single template")
+}
+
+type TemplateDumpOptions struct {
+	Tag string
+}
+
+func (o *TemplateDumpOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Only include templates with the tag")
+}
@@ -11,9 +11,25 @@ func TestTemplateCreateOptions(t *testing.T) {
 }
 
 func TestTemplateGetOptions(t *testing.T) {
-	checkFlags(t, &TemplateGetOptions{}, []string{"all"})
+	checkFlags(t, &TemplateGetOptions{}, []string{"all", "tag"})
 }
 
 func TestTemplateLoadOptions(t *testing.T) {
 	checkFlags(t, &TemplateLoadOptions{}, []string{"type", "group"})
 }
+
+func TestTemplateTestOptions(t *testing.T) {
+	checkFlags(t, &TemplateTestOptions{}, []string{"input", "expect", "type"})
+}
+
+func TestTemplateExportOptions(t *testing.T) {
+	checkFlags(t, &TemplateExportOptions{}, []string{"expand", "tag"})
+}
+
+func TestTemplateCopyOptions(t *testing.T) {
+	checkFlags(t, &TemplateCopyOptions{}, []string{"tag"})
+}
+
+func TestTemplateDumpOptions(t *testing.T) {
+	checkFlags(t, &TemplateDumpOptions{}, []string{"tag"})
+}
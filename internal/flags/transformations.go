@@ -18,8 +18,39 @@ func (o *TransformationCreateOptions) Flags(cmd *cobra.Command) {
 
 type TransformationGetOptions struct {
 	All bool
+	Tag string
 }
 
 func (o *TransformationGetOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Include all transformations")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Only include transformations with the tag")
+}
+
+type TransformationRunOptions struct {
+	Input  string
+	Expect string
+}
+
+func (o *TransformationRunOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Input, "input", o.Input, "Incoming values as a JSON string or @path to a JSON or YAML file (REQUIRED)")
+	cmd.MarkFlagRequired("input")
+	cmd.Flags().StringVar(&o.Expect, "expect", o.Expect, "Compare the outgoing values against the expected result in @path")
+}
+
+type TransformationExportOptions struct {
+	Expand bool
+	Tag    string
+}
+
+func (o *TransformationExportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Expand, "expand", o.Expand, "Write the schemas and each function to separate files")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Export every transformation with the tag instead of a single transformation")
+}
+
+type TransformationCopyOptions struct {
+	Tag string
+}
+
+func (o *TransformationCopyOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Copy every transformation with the tag instead of a single transformation")
 }
@@ -11,5 +11,17 @@ func TestTransformationCreateOptions(t *testing.T) {
 }
 
 func TestTransformationGetOptions(t *testing.T) {
-	checkFlags(t, &TransformationGetOptions{}, []string{"all"})
+	checkFlags(t, &TransformationGetOptions{}, []string{"all", "tag"})
+}
+
+func TestTransformationRunOptions(t *testing.T) {
+	checkFlags(t, &TransformationRunOptions{}, []string{"input", "expect"})
+}
+
+func TestTransformationExportOptions(t *testing.T) {
+	checkFlags(t, &TransformationExportOptions{}, []string{"expand", "tag"})
+}
+
+func TestTransformationCopyOptions(t *testing.T) {
+	checkFlags(t, &TransformationCopyOptions{}, []string{"tag"})
 }
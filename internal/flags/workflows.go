@@ -10,8 +10,52 @@ import (
 
 type WorkflowGetOptions struct {
 	All bool
+	Tag string
 }
 
 func (o *WorkflowGetOptions) Flags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", o.All, "Include all workflows")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Only include workflows with the tag")
+}
+
+type WorkflowCopyOptions struct {
+	WithDependencies bool
+	Tag              string
+}
+
+func (o *WorkflowCopyOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.WithDependencies, "with-dependencies", o.WithDependencies, "Copy all assets the workflow depends on")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Copy every workflow with the tag instead of a single workflow")
+}
+
+type WorkflowExportOptions struct {
+	Format           string
+	WithDependencies bool
+	Tag              string
+}
+
+func (o *WorkflowExportOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Format, "format", "json", "Export format, one of 'json', 'dot', 'mermaid' or 'svg-ready'")
+	cmd.Flags().BoolVar(&o.WithDependencies, "with-dependencies", o.WithDependencies, "Export all assets the workflow depends on along with a manifest")
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Export every workflow with the tag instead of a single workflow")
+}
+
+type WorkflowDumpOptions struct {
+	Tag string
+}
+
+func (o *WorkflowDumpOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Tag, "tag", o.Tag, "Only include workflows with the tag")
+}
+
+type WorkflowLintOptions struct {
+	Format     string
+	LintConfig string
+	Offline    bool
+}
+
+func (o *WorkflowLintOptions) Flags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.Format, "format", "text", "Report format, one of 'text', 'json' or 'sarif'")
+	cmd.Flags().StringVar(&o.LintConfig, "lint-config", o.LintConfig, "Path to a JSON or YAML file that sets rule severities")
+	cmd.Flags().BoolVar(&o.Offline, "offline", o.Offline, "Skip the checks that require connecting to the server")
 }
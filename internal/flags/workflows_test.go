@@ -7,5 +7,21 @@ package flags
 import "testing"
 
 func TestWorkflowGetOptions(t *testing.T) {
-	checkFlags(t, &WorkflowGetOptions{}, []string{"all"})
+	checkFlags(t, &WorkflowGetOptions{}, []string{"all", "tag"})
+}
+
+func TestWorkflowExportOptions(t *testing.T) {
+	checkFlags(t, &WorkflowExportOptions{}, []string{"format", "tag", "with-dependencies"})
+}
+
+func TestWorkflowCopyOptions(t *testing.T) {
+	checkFlags(t, &WorkflowCopyOptions{}, []string{"tag", "with-dependencies"})
+}
+
+func TestWorkflowDumpOptions(t *testing.T) {
+	checkFlags(t, &WorkflowDumpOptions{}, []string{"tag"})
+}
+
+func TestWorkflowLintOptions(t *testing.T) {
+	checkFlags(t, &WorkflowLintOptions{}, []string{"format", "lint-config", "offline"})
 }
@@ -14,7 +14,8 @@ func NewAnalyticTemplateHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		runners.NewAnalyticTemplateRunner(rt.GetClient(), rt.GetConfig()),
 		desc[analyticTemplatesDescriptor],
 		&AssetHandlerFlags{
-			Get: &flags.AnalyticTemplateGetOptions{},
+			Get:  &flags.AnalyticTemplateGetOptions{},
+			Test: &flags.AnalyticTemplateTestOptions{},
 		},
 	)
 }
@@ -0,0 +1,39 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package handlers
+
+import (
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/runners"
+	"github.com/spf13/cobra"
+)
+
+type ApplyHandler struct {
+	runner     *runners.ApplyRunner
+	runtime    *Runtime
+	descriptor DescriptorMap
+}
+
+func NewApplyHandler(rt *Runtime) ApplyHandler {
+	return ApplyHandler{
+		runner:     runners.NewApplyRunner(rt.GetClient(), rt.GetConfig()),
+		runtime:    rt,
+		descriptor: rt.GetDescriptors()[applyDescriptor],
+	}
+}
+
+// Command adds the `apply -f <path>` command.
+func (h ApplyHandler) Command() *cobra.Command {
+	logging.Trace()
+	options := &flags.ApplyOptions{}
+	cmd := NewCommand(NewCommandRunner("apply", h.descriptor, h.runner.Apply, h.runtime, options))
+	if cmd == nil {
+		return nil
+	}
+	cmd.Args = cobra.NoArgs
+	options.Flags(cmd)
+	return cmd
+}
@@ -33,6 +33,34 @@ type AssetHandlerFlags struct {
 
 	Dump flags.Flagger
 	Load flags.Flagger
+
+	Render flags.Flagger
+
+	Backup flags.Flagger
+
+	Run flags.Flagger
+
+	Add flags.Flagger
+
+	Remove flags.Flagger
+
+	Set flags.Flagger
+
+	Attach flags.Flagger
+
+	Sync flags.Flagger
+
+	Test flags.Flagger
+
+	Lint flags.Flagger
+
+	Rename flags.Flagger
+
+	Refactor flags.Flagger
+
+	Tag flags.Flagger
+
+	Migrate flags.Flagger
 }
 
 // AssetHandler provides command generation for asset-based resources.
@@ -50,6 +78,20 @@ type AssetHandler struct {
 	inspector  runners.Inspector
 	dumper     runners.Dumper
 	loader     runners.Loader
+	renderer   runners.Renderer
+	backuper   runners.Backuper
+	executor   runners.Executor
+	adder      runners.Adder
+	remover    runners.Remover
+	setter     runners.Setter
+	attacher   runners.Attacher
+	syncer     runners.Syncer
+	tester     runners.Tester
+	linter     runners.Linter
+	renamer    runners.Renamer
+	refactorer runners.Refactorer
+	tagger     runners.Tagger
+	migrater   runners.Migrater
 
 	descriptor DescriptorMap
 	flags      *AssetHandlerFlags
@@ -99,7 +141,49 @@ func NewAssetHandler(runner runners.Runner, dm DescriptorMap, flagsArg *AssetHan
 	if loader, ok := runner.(runners.Loader); ok {
 		handler.loader = loader
 	}
+	if renderer, ok := runner.(runners.Renderer); ok {
+		handler.renderer = renderer
+	}
+	if backuper, ok := runner.(runners.Backuper); ok {
+		handler.backuper = backuper
+	}
+	if executor, ok := runner.(runners.Executor); ok {
+		handler.executor = executor
+	}
+	if adder, ok := runner.(runners.Adder); ok {
+		handler.adder = adder
+	}
+	if remover, ok := runner.(runners.Remover); ok {
+		handler.remover = remover
+	}
+	if setter, ok := runner.(runners.Setter); ok {
+		handler.setter = setter
+	}
+	if attacher, ok := runner.(runners.Attacher); ok {
+		handler.attacher = attacher
+	}
+	if syncer, ok := runner.(runners.Syncer); ok {
+		handler.syncer = syncer
+	}
+	if tester, ok := runner.(runners.Tester); ok {
+		handler.tester = tester
+	}
 
+	if linter, ok := runner.(runners.Linter); ok {
+		handler.linter = linter
+	}
+	if renamer, ok := runner.(runners.Renamer); ok {
+		handler.renamer = renamer
+	}
+	if refactorer, ok := runner.(runners.Refactorer); ok {
+		handler.refactorer = refactorer
+	}
+	if tagger, ok := runner.(runners.Tagger); ok {
+		handler.tagger = tagger
+	}
+	if migrater, ok := runner.(runners.Migrater); ok {
+		handler.migrater = migrater
+	}
 	return handler
 }
 
@@ -181,7 +265,7 @@ func (h AssetHandler) Copy(runtime *Runtime) *cobra.Command {
 	common := &flags.AssetCopyCommon{}
 	cmd := h.newCommand("copy", runtime, h.copier.Copy, common)
 	if cmd != nil {
-		cmd.Args = cobra.ExactArgs(1)
+		cmd.Args = exactArgsUnlessTagged(1)
 		common.Flags(cmd)
 		if h.flags.Copy != nil {
 			h.flags.Copy.Flags(cmd)
@@ -227,7 +311,7 @@ func (h AssetHandler) Export(runtime *Runtime) *cobra.Command {
 	common := &flags.AssetExportCommon{}
 	cmd := h.newCommand("export", runtime, h.exporter.Export, common)
 	if cmd != nil {
-		cmd.Args = cobra.ExactArgs(1)
+		cmd.Args = exactArgsUnlessTagged(1)
 		common.Flags(cmd)
 		if h.flags.Export != nil {
 			h.flags.Export.Flags(cmd)
@@ -340,3 +424,224 @@ func (h AssetHandler) Load(runtime *Runtime) *cobra.Command {
 	}
 	return cmd
 }
+
+// Render returns the 'render' command if the runner supports the Renderer interface.
+func (h AssetHandler) Render(runtime *Runtime) *cobra.Command {
+	if h.renderer == nil {
+		return nil
+	}
+	cmd := h.newCommand("render", runtime, h.renderer.Render, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Render != nil {
+			h.flags.Render.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Backup returns the 'backup' command if the runner supports the Backuper interface.
+func (h AssetHandler) Backup(runtime *Runtime) *cobra.Command {
+	if h.backuper == nil {
+		return nil
+	}
+	common := &flags.AssetExportCommon{}
+	cmd := h.newCommand("backup", runtime, h.backuper.Backup, common)
+	if cmd != nil {
+		cmd.Args = cobra.NoArgs
+		common.Flags(cmd)
+		if h.flags.Backup != nil {
+			h.flags.Backup.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Run returns the 'run' command if the runner supports the Executor interface.
+func (h AssetHandler) Run(runtime *Runtime) *cobra.Command {
+	if h.executor == nil {
+		return nil
+	}
+	cmd := h.newCommand("run", runtime, h.executor.Run, nil)
+	if cmd != nil {
+		if h.flags.Run != nil {
+			h.flags.Run.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Add returns the 'add' command if the runner supports the Adder interface.
+func (h AssetHandler) Add(runtime *Runtime) *cobra.Command {
+	if h.adder == nil {
+		return nil
+	}
+	cmd := h.newCommand("add", runtime, h.adder.Add, nil)
+	if cmd != nil {
+		if h.flags.Add != nil {
+			h.flags.Add.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Remove returns the 'remove' command if the runner supports the Remover interface.
+func (h AssetHandler) Remove(runtime *Runtime) *cobra.Command {
+	if h.remover == nil {
+		return nil
+	}
+	cmd := h.newCommand("remove", runtime, h.remover.Remove, nil)
+	if cmd != nil {
+		if h.flags.Remove != nil {
+			h.flags.Remove.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Set returns the 'set' command if the runner supports the Setter interface.
+func (h AssetHandler) Set(runtime *Runtime) *cobra.Command {
+	if h.setter == nil {
+		return nil
+	}
+	cmd := h.newCommand("set", runtime, h.setter.Set, nil)
+	if cmd != nil {
+		if h.flags.Set != nil {
+			h.flags.Set.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Attach returns the 'attach' command if the runner supports the Attacher interface.
+func (h AssetHandler) Attach(runtime *Runtime) *cobra.Command {
+	if h.attacher == nil {
+		return nil
+	}
+	cmd := h.newCommand("attach", runtime, h.attacher.Attach, nil)
+	if cmd != nil {
+		if h.flags.Attach != nil {
+			h.flags.Attach.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Sync returns the 'sync' command if the runner supports the Syncer interface.
+func (h AssetHandler) Sync(runtime *Runtime) *cobra.Command {
+	if h.syncer == nil {
+		return nil
+	}
+	cmd := h.newCommand("sync", runtime, h.syncer.Sync, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Sync != nil {
+			h.flags.Sync.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Test returns the 'test' command if the runner supports the Tester interface.
+func (h AssetHandler) Test(runtime *Runtime) *cobra.Command {
+	if h.tester == nil {
+		return nil
+	}
+	cmd := h.newCommand("test", runtime, h.tester.Test, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Test != nil {
+			h.flags.Test.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Lint returns the 'lint' command if the runner supports the Linter interface.
+func (h AssetHandler) Lint(runtime *Runtime) *cobra.Command {
+	if h.linter == nil {
+		return nil
+	}
+	cmd := h.newCommand("lint", runtime, h.linter.Lint, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Lint != nil {
+			h.flags.Lint.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Rename returns the 'rename' command if the runner supports the Renamer interface.
+func (h AssetHandler) Rename(runtime *Runtime) *cobra.Command {
+	if h.renamer == nil {
+		return nil
+	}
+	common := &flags.AssetRenameCommon{}
+	cmd := h.newCommand("rename", runtime, h.renamer.Rename, common)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(2)
+		common.Flags(cmd)
+		if h.flags.Rename != nil {
+			h.flags.Rename.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Refactor returns the 'refactor' command if the runner supports the Refactorer interface.
+func (h AssetHandler) Refactor(runtime *Runtime) *cobra.Command {
+	if h.refactorer == nil {
+		return nil
+	}
+	cmd := h.newCommand("refactor", runtime, h.refactorer.Refactor, nil)
+	if cmd != nil {
+		if h.flags.Refactor != nil {
+			h.flags.Refactor.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Tag returns the 'tag' command if the runner supports the Tagger interface.
+func (h AssetHandler) Tag(runtime *Runtime) *cobra.Command {
+	if h.tagger == nil {
+		return nil
+	}
+	cmd := h.newCommand("tag", runtime, h.tagger.Tag, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Tag != nil {
+			h.flags.Tag.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// Migrate returns the 'migrate' command if the runner supports the Migrater
+// interface.
+func (h AssetHandler) Migrate(runtime *Runtime) *cobra.Command {
+	if h.migrater == nil {
+		return nil
+	}
+	cmd := h.newCommand("migrate", runtime, h.migrater.Migrate, nil)
+	if cmd != nil {
+		cmd.Args = cobra.ExactArgs(1)
+		if h.flags.Migrate != nil {
+			h.flags.Migrate.Flags(cmd)
+		}
+	}
+	return cmd
+}
+
+// exactArgsUnlessTagged requires exactly n arguments unless the command
+// selects assets with the `--tag` flag, in which case no arguments are
+// accepted.
+func exactArgsUnlessTagged(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if f := cmd.Flags().Lookup("tag"); f != nil && f.Changed {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
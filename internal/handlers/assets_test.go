@@ -32,6 +32,19 @@ type mockAssetRunner struct {
 	supportsInspector  bool
 	supportsDumper     bool
 	supportsLoader     bool
+	supportsRenderer   bool
+	supportsBackuper   bool
+	supportsExecutor   bool
+	supportsAdder      bool
+	supportsRemover    bool
+	supportsSetter     bool
+	supportsAttacher   bool
+	supportsSyncer     bool
+	supportsTester     bool
+	supportsLinter     bool
+	supportsRenamer    bool
+	supportsRefactorer bool
+	supportsTagger     bool
 }
 
 // Implement runners.Reader
@@ -149,6 +162,110 @@ func (m *mockAssetRunner) Load(req runners.Request) (*runners.Response, error) {
 	return &runners.Response{Text: "load"}, nil
 }
 
+// Implement runners.Renderer
+func (m *mockAssetRunner) Render(req runners.Request) (*runners.Response, error) {
+	if !m.supportsRenderer {
+		return nil, nil
+	}
+	return &runners.Response{Text: "render"}, nil
+}
+
+// Implement runners.Backuper
+func (m *mockAssetRunner) Backup(req runners.Request) (*runners.Response, error) {
+	if !m.supportsBackuper {
+		return nil, nil
+	}
+	return &runners.Response{Text: "backup"}, nil
+}
+
+// Implement runners.Executor
+func (m *mockAssetRunner) Run(req runners.Request) (*runners.Response, error) {
+	if !m.supportsExecutor {
+		return nil, nil
+	}
+	return &runners.Response{Text: "run"}, nil
+}
+
+// Implement runners.Adder
+func (m *mockAssetRunner) Add(req runners.Request) (*runners.Response, error) {
+	if !m.supportsAdder {
+		return nil, nil
+	}
+	return &runners.Response{Text: "add"}, nil
+}
+
+// Implement runners.Remover
+func (m *mockAssetRunner) Remove(req runners.Request) (*runners.Response, error) {
+	if !m.supportsRemover {
+		return nil, nil
+	}
+	return &runners.Response{Text: "remove"}, nil
+}
+
+// Implement runners.Setter
+func (m *mockAssetRunner) Set(req runners.Request) (*runners.Response, error) {
+	if !m.supportsSetter {
+		return nil, nil
+	}
+	return &runners.Response{Text: "set"}, nil
+}
+
+// Implement runners.Attacher
+func (m *mockAssetRunner) Attach(req runners.Request) (*runners.Response, error) {
+	if !m.supportsAttacher {
+		return nil, nil
+	}
+	return &runners.Response{Text: "attach"}, nil
+}
+
+// Implement runners.Syncer
+func (m *mockAssetRunner) Sync(req runners.Request) (*runners.Response, error) {
+	if !m.supportsSyncer {
+		return nil, nil
+	}
+	return &runners.Response{Text: "sync"}, nil
+}
+
+// Implement runners.Tester
+func (m *mockAssetRunner) Test(req runners.Request) (*runners.Response, error) {
+	if !m.supportsTester {
+		return nil, nil
+	}
+	return &runners.Response{Text: "test"}, nil
+}
+
+// Implement runners.Linter
+func (m *mockAssetRunner) Lint(req runners.Request) (*runners.Response, error) {
+	if !m.supportsLinter {
+		return nil, nil
+	}
+	return &runners.Response{Text: "lint"}, nil
+}
+
+// Implement runners.Renamer
+func (m *mockAssetRunner) Rename(req runners.Request) (*runners.Response, error) {
+	if !m.supportsRenamer {
+		return nil, nil
+	}
+	return &runners.Response{Text: "rename"}, nil
+}
+
+// Implement runners.Refactorer
+func (m *mockAssetRunner) Refactor(req runners.Request) (*runners.Response, error) {
+	if !m.supportsRefactorer {
+		return nil, nil
+	}
+	return &runners.Response{Text: "refactor"}, nil
+}
+
+// Implement runners.Tagger
+func (m *mockAssetRunner) Tag(req runners.Request) (*runners.Response, error) {
+	if !m.supportsTagger {
+		return nil, nil
+	}
+	return &runners.Response{Text: "tag"}, nil
+}
+
 func createTestDescriptors() DescriptorMap {
 	return DescriptorMap{
 		"get": cmdutils.Descriptor{
@@ -211,6 +328,58 @@ func createTestDescriptors() DescriptorMap {
 			Use:         "resources",
 			Description: "load resources",
 		},
+		"render": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "render resource",
+		},
+		"backup": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "backup resource",
+		},
+		"run": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "run resource",
+		},
+		"add": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "add resource",
+		},
+		"remove": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "remove resource",
+		},
+		"set": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "set resource",
+		},
+		"attach": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "attach resource",
+		},
+		"sync": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "sync resource",
+		},
+		"test": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "test resource",
+		},
+		"lint": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "lint resource",
+		},
+		"rename": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "rename resource",
+		},
+		"refactor": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "refactor resource",
+		},
+		"tag": cmdutils.Descriptor{
+			Use:         "resource",
+			Description: "tag resource",
+		},
 	}
 }
 
@@ -282,6 +451,36 @@ func TestAssetHandler_Describe_ExactArgsSet(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTransformationHandler_Run_ExactArgsSet(t *testing.T) {
+	desc, err := loadDescriptors()
+	require.NoError(t, err)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := NewTransformationHandler(rt, desc).Run(rt)
+	require.NotNil(t, cmd)
+	require.NotNil(t, cmd.Args)
+
+	assert.NoError(t, cmd.Args(cmd, []string{"jst"}))
+	assert.Error(t, cmd.Args(cmd, []string{}))
+}
+
+func TestExactArgsUnlessTagged(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("tag", "", "")
+
+	validate := exactArgsUnlessTagged(1)
+
+	assert.NoError(t, validate(cmd, []string{"arg1"}))
+	assert.Error(t, validate(cmd, []string{}))
+
+	require.NoError(t, cmd.Flags().Set("tag", "release"))
+
+	assert.NoError(t, validate(cmd, []string{}))
+	assert.Error(t, validate(cmd, []string{"arg1"}))
+}
+
 func TestAssetHandler_Create_WithSupport(t *testing.T) {
 	runner := &mockAssetRunner{
 		supportsWriter: true,
@@ -475,6 +674,214 @@ func TestAssetHandler_Load_WithSupport(t *testing.T) {
 	assert.NotNil(t, cmd)
 }
 
+func TestAssetHandler_Render_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsRenderer: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Render(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Backup_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsBackuper: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Backup(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Run_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsExecutor: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Run(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Add_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsAdder: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Add(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Remove_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsRemover: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Remove(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Set_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsSetter: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Set(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Attach_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsAttacher: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Attach(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Sync_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsSyncer: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Sync(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Test_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsTester: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Test(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Lint_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsLinter: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Lint(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Rename_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsRenamer: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Rename(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Refactor_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsRefactorer: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Refactor(rt)
+
+	assert.NotNil(t, cmd)
+}
+
+func TestAssetHandler_Tag_WithSupport(t *testing.T) {
+	runner := &mockAssetRunner{
+		supportsTagger: true,
+	}
+
+	desc := createTestDescriptors()
+	handler := NewAssetHandler(runner, desc, nil)
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{})
+	require.NoError(t, err)
+
+	cmd := handler.Tag(rt)
+
+	assert.NotNil(t, cmd)
+}
+
 func TestAssetHandler_WithCustomFlags(t *testing.T) {
 	runner := &mockAssetRunner{
 		supportsReader: true,
@@ -519,6 +926,19 @@ func TestAssetHandlerFlags_AllFields(t *testing.T) {
 		Inspect:  &mockFlagger{},
 		Dump:     &mockFlagger{},
 		Load:     &mockFlagger{},
+		Render:   &mockFlagger{},
+		Backup:   &mockFlagger{},
+		Run:      &mockFlagger{},
+		Add:      &mockFlagger{},
+		Remove:   &mockFlagger{},
+		Set:      &mockFlagger{},
+		Attach:   &mockFlagger{},
+		Sync:     &mockFlagger{},
+		Test:     &mockFlagger{},
+		Lint:     &mockFlagger{},
+		Rename:   &mockFlagger{},
+		Refactor: &mockFlagger{},
+		Tag:      &mockFlagger{},
 	}
 
 	assert.NotNil(t, flags.Create)
@@ -536,4 +956,17 @@ func TestAssetHandlerFlags_AllFields(t *testing.T) {
 	assert.NotNil(t, flags.Inspect)
 	assert.NotNil(t, flags.Dump)
 	assert.NotNil(t, flags.Load)
+	assert.NotNil(t, flags.Render)
+	assert.NotNil(t, flags.Backup)
+	assert.NotNil(t, flags.Run)
+	assert.NotNil(t, flags.Add)
+	assert.NotNil(t, flags.Remove)
+	assert.NotNil(t, flags.Set)
+	assert.NotNil(t, flags.Attach)
+	assert.NotNil(t, flags.Sync)
+	assert.NotNil(t, flags.Test)
+	assert.NotNil(t, flags.Lint)
+	assert.NotNil(t, flags.Rename)
+	assert.NotNil(t, flags.Refactor)
+	assert.NotNil(t, flags.Tag)
 }
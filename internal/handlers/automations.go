@@ -15,6 +15,8 @@ func NewAutomationHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		desc[automationsDescriptor],
 		&AssetHandlerFlags{
 			Create: &flags.AutomationCreateOptions{},
+			Copy:   &flags.AutomationCopyOptions{},
+			Export: &flags.AutomationExportOptions{},
 		},
 	)
 }
@@ -15,6 +15,7 @@ func NewCommandTemplateHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		desc[commandTemplatesDescriptor],
 		&AssetHandlerFlags{
 			Get: &flags.CommandTemplateGetOptions{},
+			Run: &flags.CommandTemplateRunOptions{},
 		},
 	)
 }
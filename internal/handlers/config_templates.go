@@ -0,0 +1,21 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package handlers
+
+import (
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/runners"
+)
+
+func NewConfigTemplateHandler(rt *Runtime, desc Descriptors) AssetHandler {
+	return NewAssetHandler(
+		runners.NewConfigTemplateRunner(rt.GetClient(), rt.GetConfig()),
+		desc[configTemplatesDescriptor],
+		&AssetHandlerFlags{
+			Create: &flags.ConfigTemplateCreateOptions{},
+			Render: &flags.ConfigTemplateRenderOptions{},
+		},
+	)
+}
@@ -15,7 +15,8 @@ import (
 const descriptorsDir = "descriptors"
 
 const (
-	apiDescriptor = "api"
+	apiDescriptor   = "api"
+	applyDescriptor = "apply"
 
 	accountsDescriptor     = "accounts"
 	groupsDescriptor       = "groups"
@@ -36,11 +37,17 @@ const (
 
 	automationsDescriptor = "automations"
 
+	dependenciesDescriptor  = "dependencies"
+	replaceAppDescriptor    = "replace_app"
+	permissionsDescriptor   = "permissions"
+	migrateAssetsDescriptor = "migrate_assets"
+
 	commandTemplatesDescriptor  = "command_templates"
 	workflowsDescriptor         = "workflows"
 	transformationsDescriptor   = "transformations"
 	jsonformsDescriptor         = "jsonforms"
 	projectsDescriptor          = "projects"
+	projectMembersDescriptor    = "project_members"
 	agentProjectsDescriptor     = "agent_projects"
 	analyticTemplatesDescriptor = "analytic_templates"
 	templatesDescriptor         = "templates"
@@ -49,6 +56,8 @@ const (
 	deviceGroupsDescriptor         = "devicegroups"
 	configurationParsersDescriptor = "configuration_parsers"
 	gctreesDescriptor              = "gctrees"
+	configTemplatesDescriptor      = "config_templates"
+	complianceDescriptor           = "compliance"
 
 	serverDescriptor = "server"
 
@@ -15,6 +15,7 @@ func NewDeviceGroupHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		desc[deviceGroupsDescriptor],
 		&AssetHandlerFlags{
 			Create: &flags.DeviceGroupCreateOptions{},
+			Sync:   &flags.DeviceGroupSyncOptions{},
 		},
 	)
 }
@@ -5,6 +5,7 @@
 package handlers
 
 import (
+	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/runners"
 )
 
@@ -12,6 +13,11 @@ func NewDeviceHandler(rt *Runtime, desc Descriptors) AssetHandler {
 	return NewAssetHandler(
 		runners.NewDeviceRunner(rt.GetClient(), rt.GetConfig()),
 		desc[devicesDescriptor],
-		nil,
+		&AssetHandlerFlags{
+			Create: &flags.DeviceCreateOptions{},
+			Delete: &flags.DeviceDeleteOptions{},
+			Import: &flags.DeviceImportOptions{},
+			Backup: &flags.DeviceBackupOptions{},
+		},
 	)
 }
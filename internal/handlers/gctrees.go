@@ -5,6 +5,7 @@
 package handlers
 
 import (
+	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/runners"
 )
 
@@ -12,6 +13,11 @@ func NewGoldenConfigHandler(rt *Runtime, desc Descriptors) AssetHandler {
 	return NewAssetHandler(
 		runners.NewGoldenConfigRunner(rt.GetClient(), rt.GetConfig()),
 		desc[gctreesDescriptor],
-		nil,
+		&AssetHandlerFlags{
+			Add:    &flags.GoldenConfigNodeOptions{},
+			Remove: &flags.GoldenConfigNodeOptions{},
+			Set:    &flags.GoldenConfigVariablesOptions{},
+			Attach: &flags.GoldenConfigDevicesOptions{},
+		},
 	)
 }
@@ -111,6 +111,11 @@ func NewHandler(rt *Runtime) Handler {
 		NewCommandTemplateHandler(rt, descriptors),
 		NewAnalyticTemplateHandler(rt, descriptors),
 		NewTemplateHandler(rt, descriptors),
+		NewDependencyHandler(rt, descriptors),
+		NewReplaceAppHandler(rt, descriptors),
+		NewPermissionHandler(rt, descriptors),
+		NewProjectMemberHandler(rt, descriptors),
+		NewAssetMigrationHandler(rt, descriptors),
 
 		// Operations Manager Handlers
 		NewAutomationHandler(rt, descriptors),
@@ -136,6 +141,8 @@ func NewHandler(rt *Runtime) Handler {
 		NewDeviceGroupHandler(rt, descriptors),
 		NewConfigurationParserHandler(rt, descriptors),
 		NewGoldenConfigHandler(rt, descriptors),
+		NewConfigTemplateHandler(rt, descriptors),
+		NewComplianceHandler(rt, descriptors),
 
 		// Lifecycle Manager handlers
 		NewModelHandler(rt, descriptors),
@@ -345,3 +352,171 @@ func (h Handler) LoadCommands() []*cobra.Command {
 	}
 	return commands
 }
+
+// RenderCommands returns all 'render' commands from registered handlers.
+func (h Handler) RenderCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Renderers() {
+		cmd := ele.Render(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// BackupCommands returns all 'backup' commands from registered handlers.
+func (h Handler) BackupCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Backupers() {
+		cmd := ele.Backup(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// RunCommands returns all 'run' commands from registered handlers.
+func (h Handler) RunCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Executors() {
+		cmd := ele.Run(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// AddCommands returns all 'add' commands from registered handlers.
+func (h Handler) AddCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Adders() {
+		cmd := ele.Add(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// RemoveCommands returns all 'remove' commands from registered handlers.
+func (h Handler) RemoveCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Removers() {
+		cmd := ele.Remove(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// SetCommands returns all 'set' commands from registered handlers.
+func (h Handler) SetCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Setters() {
+		cmd := ele.Set(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// AttachCommands returns all 'attach' commands from registered handlers.
+func (h Handler) AttachCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Attachers() {
+		cmd := ele.Attach(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// SyncCommands returns all 'sync' commands from registered handlers.
+func (h Handler) SyncCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Syncers() {
+		cmd := ele.Sync(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// TestCommands returns all 'test' commands from registered handlers.
+func (h Handler) TestCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Testers() {
+		cmd := ele.Test(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// LintCommands returns all 'lint' commands from registered handlers.
+func (h Handler) LintCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Linters() {
+		cmd := ele.Lint(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// RenameCommands returns all 'rename' commands from registered handlers.
+func (h Handler) RenameCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Renamers() {
+		cmd := ele.Rename(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// RefactorCommands returns all 'refactor' commands from registered handlers.
+func (h Handler) RefactorCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Refactorers() {
+		cmd := ele.Refactor(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// TagCommands returns all 'tag' commands from registered handlers.
+func (h Handler) TagCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Taggers() {
+		cmd := ele.Tag(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
+
+// MigrateCommands returns all 'migrate' commands from registered handlers.
+func (h Handler) MigrateCommands() []*cobra.Command {
+	var commands []*cobra.Command
+	for _, ele := range h.registry.Migraters() {
+		cmd := ele.Migrate(h.runtime)
+		if cmd != nil {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands
+}
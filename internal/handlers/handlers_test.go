@@ -344,6 +344,20 @@ func TestHandler_AllCommandMethods_ReturnCommands(t *testing.T) {
 	assert.NotNil(t, handler.EditCommands())
 	assert.NotNil(t, handler.DumpCommands())
 	assert.NotNil(t, handler.LoadCommands())
+	assert.NotNil(t, handler.RenderCommands())
+	assert.NotNil(t, handler.BackupCommands())
+	assert.NotNil(t, handler.RunCommands())
+	assert.NotNil(t, handler.AddCommands())
+	assert.NotNil(t, handler.RemoveCommands())
+	assert.NotNil(t, handler.SetCommands())
+	assert.NotNil(t, handler.AttachCommands())
+	assert.NotNil(t, handler.SyncCommands())
+	assert.NotNil(t, handler.TestCommands())
+	assert.NotNil(t, handler.LintCommands())
+	assert.NotNil(t, handler.RenameCommands())
+	assert.NotNil(t, handler.RefactorCommands())
+	assert.NotNil(t, handler.TagCommands())
+	assert.NotNil(t, handler.MigrateCommands())
 }
 
 func TestHandler_CommandsHaveRunE(t *testing.T) {
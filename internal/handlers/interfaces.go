@@ -52,3 +52,59 @@ type Dumper interface {
 type Loader interface {
 	Load(*Runtime) *cobra.Command
 }
+
+type Renderer interface {
+	Render(*Runtime) *cobra.Command
+}
+
+type Backuper interface {
+	Backup(*Runtime) *cobra.Command
+}
+
+type Executor interface {
+	Run(*Runtime) *cobra.Command
+}
+
+type Adder interface {
+	Add(*Runtime) *cobra.Command
+}
+
+type Remover interface {
+	Remove(*Runtime) *cobra.Command
+}
+
+type Setter interface {
+	Set(*Runtime) *cobra.Command
+}
+
+type Attacher interface {
+	Attach(*Runtime) *cobra.Command
+}
+
+type Syncer interface {
+	Sync(*Runtime) *cobra.Command
+}
+
+type Tester interface {
+	Test(*Runtime) *cobra.Command
+}
+
+type Linter interface {
+	Lint(*Runtime) *cobra.Command
+}
+
+type Renamer interface {
+	Rename(*Runtime) *cobra.Command
+}
+
+type Refactorer interface {
+	Refactor(*Runtime) *cobra.Command
+}
+
+type Tagger interface {
+	Tag(*Runtime) *cobra.Command
+}
+
+type Migrater interface {
+	Migrate(*Runtime) *cobra.Command
+}
@@ -14,6 +14,7 @@ func NewJsonFormHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		runners.NewJsonFormRunner(rt.GetClient(), rt.GetConfig()),
 		desc[jsonformsDescriptor],
 		&AssetHandlerFlags{
+			Export: &flags.JsonFormExportOptions{},
 			Get:    &flags.JsonFormGetOptions{},
 			Create: &flags.JsonFormCreateOptions{},
 		},
@@ -0,0 +1,20 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package handlers
+
+import (
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/runners"
+)
+
+func NewPermissionHandler(rt *Runtime, desc Descriptors) AssetHandler {
+	return NewAssetHandler(
+		runners.NewPermissionRunner(rt.GetClient(), rt.GetConfig()),
+		desc[permissionsDescriptor],
+		&AssetHandlerFlags{
+			Set: &flags.PermissionSetOptions{},
+		},
+	)
+}
@@ -14,6 +14,7 @@ func NewProjectHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		runners.NewProjectRunner(rt.GetClient(), rt.GetConfig()),
 		desc[projectsDescriptor],
 		&AssetHandlerFlags{
+			Create: &flags.ProjectCreateOptions{},
 			Import: &flags.ProjectImportOptions{},
 			Export: &flags.ProjectExportOptions{},
 			Copy:   &flags.ProjectCopyOptions{},
@@ -18,6 +18,20 @@ type Registry struct {
 	inspectors  []Inspector
 	dumpers     []Dumper
 	loaders     []Loader
+	renderers   []Renderer
+	backupers   []Backuper
+	executors   []Executor
+	adders      []Adder
+	removers    []Remover
+	setters     []Setter
+	attachers   []Attacher
+	syncers     []Syncer
+	testers     []Tester
+	linters     []Linter
+	renamers    []Renamer
+	refactorers []Refactorer
+	taggers     []Tagger
+	migraters   []Migrater
 }
 
 // NewRegistry creates and populates a new handler registry.
@@ -56,6 +70,48 @@ func NewRegistry(handlers []any) *Registry {
 		if loader, ok := handler.(Loader); ok {
 			r.loaders = append(r.loaders, loader)
 		}
+		if renderer, ok := handler.(Renderer); ok {
+			r.renderers = append(r.renderers, renderer)
+		}
+		if backuper, ok := handler.(Backuper); ok {
+			r.backupers = append(r.backupers, backuper)
+		}
+		if executor, ok := handler.(Executor); ok {
+			r.executors = append(r.executors, executor)
+		}
+		if adder, ok := handler.(Adder); ok {
+			r.adders = append(r.adders, adder)
+		}
+		if remover, ok := handler.(Remover); ok {
+			r.removers = append(r.removers, remover)
+		}
+		if setter, ok := handler.(Setter); ok {
+			r.setters = append(r.setters, setter)
+		}
+		if attacher, ok := handler.(Attacher); ok {
+			r.attachers = append(r.attachers, attacher)
+		}
+		if syncer, ok := handler.(Syncer); ok {
+			r.syncers = append(r.syncers, syncer)
+		}
+		if tester, ok := handler.(Tester); ok {
+			r.testers = append(r.testers, tester)
+		}
+		if linter, ok := handler.(Linter); ok {
+			r.linters = append(r.linters, linter)
+		}
+		if renamer, ok := handler.(Renamer); ok {
+			r.renamers = append(r.renamers, renamer)
+		}
+		if refactorer, ok := handler.(Refactorer); ok {
+			r.refactorers = append(r.refactorers, refactorer)
+		}
+		if tagger, ok := handler.(Tagger); ok {
+			r.taggers = append(r.taggers, tagger)
+		}
+		if migrater, ok := handler.(Migrater); ok {
+			r.migraters = append(r.migraters, migrater)
+		}
 	}
 
 	return r
@@ -111,3 +167,73 @@ func (r *Registry) Dumpers() []Dumper {
 func (r *Registry) Loaders() []Loader {
 	return append([]Loader(nil), r.loaders...)
 }
+
+// Renderers returns a copy of all registered Renderer handlers.
+func (r *Registry) Renderers() []Renderer {
+	return append([]Renderer(nil), r.renderers...)
+}
+
+// Backupers returns a copy of all registered Backuper handlers.
+func (r *Registry) Backupers() []Backuper {
+	return append([]Backuper(nil), r.backupers...)
+}
+
+// Executors returns a copy of all registered Executor handlers.
+func (r *Registry) Executors() []Executor {
+	return append([]Executor(nil), r.executors...)
+}
+
+// Adders returns a copy of all registered Adder handlers.
+func (r *Registry) Adders() []Adder {
+	return append([]Adder(nil), r.adders...)
+}
+
+// Removers returns a copy of all registered Remover handlers.
+func (r *Registry) Removers() []Remover {
+	return append([]Remover(nil), r.removers...)
+}
+
+// Setters returns a copy of all registered Setter handlers.
+func (r *Registry) Setters() []Setter {
+	return append([]Setter(nil), r.setters...)
+}
+
+// Attachers returns a copy of all registered Attacher handlers.
+func (r *Registry) Attachers() []Attacher {
+	return append([]Attacher(nil), r.attachers...)
+}
+
+// Syncers returns a copy of all registered Syncer handlers.
+func (r *Registry) Syncers() []Syncer {
+	return append([]Syncer(nil), r.syncers...)
+}
+
+// Testers returns a copy of all registered Tester handlers.
+func (r *Registry) Testers() []Tester {
+	return append([]Tester(nil), r.testers...)
+}
+
+// Linters returns a copy of all registered Linter handlers.
+func (r *Registry) Linters() []Linter {
+	return append([]Linter(nil), r.linters...)
+}
+
+// Renamers returns a copy of all registered Renamer handlers.
+func (r *Registry) Renamers() []Renamer {
+	return append([]Renamer(nil), r.renamers...)
+}
+
+// Refactorers returns a copy of all registered Refactorer handlers.
+func (r *Registry) Refactorers() []Refactorer {
+	return append([]Refactorer(nil), r.refactorers...)
+}
+
+// Taggers returns a copy of all registered Tagger handlers.
+func (r *Registry) Taggers() []Tagger {
+	return append([]Tagger(nil), r.taggers...)
+}
+
+// Migraters returns a copy of all registered Migrater handlers.
+func (r *Registry) Migraters() []Migrater {
+	return append([]Migrater(nil), r.migraters...)
+}
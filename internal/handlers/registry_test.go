@@ -122,6 +122,132 @@ func (m *mockLoader) Load(rt *Runtime) *cobra.Command {
 	return &cobra.Command{Use: m.name + "-load"}
 }
 
+// mockRenderer implements the Renderer interface for testing
+type mockRenderer struct {
+	name string
+}
+
+func (m *mockRenderer) Render(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-render"}
+}
+
+// mockBackuper implements the Backuper interface for testing
+type mockBackuper struct {
+	name string
+}
+
+func (m *mockBackuper) Backup(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-backup"}
+}
+
+// mockExecutor implements the Executor interface for testing
+type mockExecutor struct {
+	name string
+}
+
+func (m *mockExecutor) Run(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-run"}
+}
+
+// mockAdder implements the Adder interface for testing
+type mockAdder struct {
+	name string
+}
+
+func (m *mockAdder) Add(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-add"}
+}
+
+// mockRemover implements the Remover interface for testing
+type mockRemover struct {
+	name string
+}
+
+func (m *mockRemover) Remove(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-remove"}
+}
+
+// mockSetter implements the Setter interface for testing
+type mockSetter struct {
+	name string
+}
+
+func (m *mockSetter) Set(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-set"}
+}
+
+// mockAttacher implements the Attacher interface for testing
+type mockAttacher struct {
+	name string
+}
+
+func (m *mockAttacher) Attach(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-attach"}
+}
+
+// mockSyncer implements the Syncer interface for testing
+type mockSyncer struct {
+	name string
+}
+
+func (m *mockSyncer) Sync(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-sync"}
+}
+
+// mockTester implements the Tester interface for testing
+type mockTester struct {
+	name string
+}
+
+func (m *mockTester) Test(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-test"}
+}
+
+// mockLinter implements the Linter interface for testing
+type mockLinter struct {
+	name string
+}
+
+func (m *mockLinter) Lint(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-lint"}
+}
+
+// mockRenamer implements the Renamer interface for testing
+type mockRenamer struct {
+	name string
+}
+
+func (m *mockRenamer) Rename(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-rename"}
+}
+
+// mockRefactorer implements the Refactorer interface for testing
+type mockRefactorer struct {
+	name string
+}
+
+func (m *mockRefactorer) Refactor(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-refactor"}
+}
+
+// mockTagger implements the Tagger interface for testing
+type mockTagger struct {
+	name string
+}
+
+func (m *mockTagger) Tag(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-tag"}
+}
+
+// mockMigrater implements the Migrater interface for testing
+type mockMigrater struct {
+	name string
+}
+
+func (m *mockMigrater) Migrate(rt *Runtime) *cobra.Command {
+	return &cobra.Command{Use: m.name + "-migrate"}
+}
+
 // mockMultiHandler implements multiple interfaces
 type mockMultiHandler struct {
 	name string
@@ -335,7 +461,7 @@ func TestRegistry_DefensiveCopies(t *testing.T) {
 }
 
 func TestRegistry_AllInterfaces(t *testing.T) {
-	// Create handlers for all 10 interfaces
+	// Create handlers for all 24 interfaces
 	handlers := []any{
 		&mockReader{name: "reader"},
 		&mockWriter{name: "writer"},
@@ -347,6 +473,20 @@ func TestRegistry_AllInterfaces(t *testing.T) {
 		&mockInspector{name: "inspector"},
 		&mockDumper{name: "dumper"},
 		&mockLoader{name: "loader"},
+		&mockRenderer{name: "renderer"},
+		&mockBackuper{name: "backuper"},
+		&mockExecutor{name: "executor"},
+		&mockAdder{name: "adder"},
+		&mockRemover{name: "remover"},
+		&mockSetter{name: "setter"},
+		&mockAttacher{name: "attacher"},
+		&mockSyncer{name: "syncer"},
+		&mockTester{name: "tester"},
+		&mockLinter{name: "linter"},
+		&mockRenamer{name: "renamer"},
+		&mockRefactorer{name: "refactorer"},
+		&mockTagger{name: "tagger"},
+		&mockMigrater{name: "migrater"},
 	}
 
 	registry := NewRegistry(handlers)
@@ -363,6 +503,20 @@ func TestRegistry_AllInterfaces(t *testing.T) {
 	assert.Len(t, registry.Inspectors(), 1)
 	assert.Len(t, registry.Dumpers(), 1)
 	assert.Len(t, registry.Loaders(), 1)
+	assert.Len(t, registry.Renderers(), 1)
+	assert.Len(t, registry.Backupers(), 1)
+	assert.Len(t, registry.Executors(), 1)
+	assert.Len(t, registry.Adders(), 1)
+	assert.Len(t, registry.Removers(), 1)
+	assert.Len(t, registry.Setters(), 1)
+	assert.Len(t, registry.Attachers(), 1)
+	assert.Len(t, registry.Syncers(), 1)
+	assert.Len(t, registry.Testers(), 1)
+	assert.Len(t, registry.Linters(), 1)
+	assert.Len(t, registry.Renamers(), 1)
+	assert.Len(t, registry.Refactorers(), 1)
+	assert.Len(t, registry.Taggers(), 1)
+	assert.Len(t, registry.Migraters(), 1)
 }
 
 func TestRegistry_TypeAssertionSafety(t *testing.T) {
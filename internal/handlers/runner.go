@@ -78,6 +78,34 @@ func withOptions(f *AssetHandlerFlags) CommandRunnerOption {
 			c.Options = f.Load
 		case "dump":
 			c.Options = f.Dump
+		case "render":
+			c.Options = f.Render
+		case "backup":
+			c.Options = f.Backup
+		case "run":
+			c.Options = f.Run
+		case "add":
+			c.Options = f.Add
+		case "remove":
+			c.Options = f.Remove
+		case "set":
+			c.Options = f.Set
+		case "attach":
+			c.Options = f.Attach
+		case "sync":
+			c.Options = f.Sync
+		case "test":
+			c.Options = f.Test
+		case "lint":
+			c.Options = f.Lint
+		case "rename":
+			c.Options = f.Rename
+		case "refactor":
+			c.Options = f.Refactor
+		case "tag":
+			c.Options = f.Tag
+		case "migrate":
+			c.Options = f.Migrate
 		}
 	}
 }
@@ -121,9 +149,13 @@ func NewCommand(c *CommandRunner) *cobra.Command {
 				Verbose: c.Runtime.IsVerbose(),
 			}
 
-			resp, err := c.Run(req)
-			if err != nil {
-				return err
+			// A runner may return both a response and an error, for
+			// instance when a check completes with failures.  In that case
+			// the response is rendered before the error is returned so the
+			// command still exits with a non-zero status.
+			resp, runErr := c.Run(req)
+			if runErr != nil && resp == nil {
+				return runErr
 			}
 
 			// Create renderer based on configured output format
@@ -134,7 +166,11 @@ func NewCommand(c *CommandRunner) *cobra.Command {
 			}
 
 			// Render the response
-			return renderer.Render(resp)
+			if err := renderer.Render(resp); err != nil {
+				return err
+			}
+
+			return runErr
 		},
 	}
 
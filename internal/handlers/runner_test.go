@@ -92,6 +92,20 @@ func TestWithOptions_AllKeys(t *testing.T) {
 		{"export", &mockFlagger{}},
 		{"load", &mockFlagger{}},
 		{"dump", &mockFlagger{}},
+		{"render", &mockFlagger{}},
+		{"backup", &mockFlagger{}},
+		{"run", &mockFlagger{}},
+		{"add", &mockFlagger{}},
+		{"remove", &mockFlagger{}},
+		{"set", &mockFlagger{}},
+		{"attach", &mockFlagger{}},
+		{"sync", &mockFlagger{}},
+		{"test", &mockFlagger{}},
+		{"lint", &mockFlagger{}},
+		{"rename", &mockFlagger{}},
+		{"refactor", &mockFlagger{}},
+		{"tag", &mockFlagger{}},
+		{"migrate", &mockFlagger{}},
 	}
 
 	for _, tt := range tests {
@@ -107,6 +121,20 @@ func TestWithOptions_AllKeys(t *testing.T) {
 				Export:   &mockFlagger{},
 				Load:     &mockFlagger{},
 				Dump:     &mockFlagger{},
+				Render:   &mockFlagger{},
+				Backup:   &mockFlagger{},
+				Run:      &mockFlagger{},
+				Add:      &mockFlagger{},
+				Remove:   &mockFlagger{},
+				Set:      &mockFlagger{},
+				Attach:   &mockFlagger{},
+				Sync:     &mockFlagger{},
+				Test:     &mockFlagger{},
+				Lint:     &mockFlagger{},
+				Rename:   &mockFlagger{},
+				Refactor: &mockFlagger{},
+				Tag:      &mockFlagger{},
+				Migrate:  &mockFlagger{},
 			}
 
 			cr := &CommandRunner{Key: tt.key}
@@ -388,6 +416,42 @@ func TestNewCommand_RunE_Error(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestNewCommand_RunE_ResponseWithError(t *testing.T) {
+	desc := DescriptorMap{
+		"run": cmdutils.Descriptor{
+			Use: "resource",
+		},
+	}
+
+	expectedErr := errors.New("check failed")
+	runFunc := func(req runners.Request) (*runners.Response, error) {
+		return &runners.Response{Text: "report"}, expectedErr
+	}
+
+	rt, err := NewRuntime(&mockClient{}, &mockConfig{}, &terminal.Config{
+		DefaultOutput: "human",
+		Pager:         false,
+	})
+	require.NoError(t, err)
+
+	cr := &CommandRunner{
+		Key:         "run",
+		Descriptors: desc,
+		Run:         runFunc,
+		Runtime:     rt,
+		Common:      &mockFlagger{},
+		Options:     &mockFlagger{},
+		Runner:      &mockRunner{},
+	}
+
+	cmd := NewCommand(cr)
+	require.NotNil(t, cmd)
+
+	// The response is rendered and the runner error is still returned
+	err = cmd.RunE(cmd, []string{})
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNewCommand_ExampleFormatting(t *testing.T) {
 	desc := DescriptorMap{
 		"get": cmdutils.Descriptor{
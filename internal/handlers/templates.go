@@ -15,8 +15,13 @@ func NewTemplateHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		desc[templatesDescriptor],
 		&AssetHandlerFlags{
 			Create: &flags.TemplateCreateOptions{},
+			Export: &flags.TemplateExportOptions{},
 			Get:    &flags.TemplateGetOptions{},
 			Load:   &flags.TemplateLoadOptions{},
+			Test:   &flags.TemplateTestOptions{},
+			Copy:   &flags.TemplateCopyOptions{},
+			Dump:   &flags.TemplateDumpOptions{},
+			Tag:    &flags.AssetTagOptions{},
 		},
 	)
 }
@@ -14,8 +14,12 @@ func NewTransformationHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		runners.NewTransformationRunner(rt.GetClient(), rt.GetConfig()),
 		desc[transformationsDescriptor],
 		&AssetHandlerFlags{
+			Export: &flags.TransformationExportOptions{},
 			Get:    &flags.TransformationGetOptions{},
 			Create: &flags.TransformationCreateOptions{},
+			Run:    &flags.TransformationRunOptions{},
+			Copy:   &flags.TransformationCopyOptions{},
+			Tag:    &flags.AssetTagOptions{},
 		},
 	)
 }
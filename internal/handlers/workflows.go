@@ -14,7 +14,12 @@ func NewWorkflowHandler(rt *Runtime, desc Descriptors) AssetHandler {
 		runners.NewWorkflowRunner(rt.GetClient(), rt.GetConfig()),
 		desc[workflowsDescriptor],
 		&AssetHandlerFlags{
-			Get: &flags.WorkflowGetOptions{},
+			Get:    &flags.WorkflowGetOptions{},
+			Copy:   &flags.WorkflowCopyOptions{},
+			Export: &flags.WorkflowExportOptions{},
+			Lint:   &flags.WorkflowLintOptions{},
+			Dump:   &flags.WorkflowDumpOptions{},
+			Tag:    &flags.AssetTagOptions{},
 		},
 	)
 }
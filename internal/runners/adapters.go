@@ -335,7 +335,7 @@ func (r *AdapterRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.adapter.json", name)
 
-	if err := exportAssetFromRequest(in, adapter, fn); err != nil {
+	if err := exportAssetFromRequest(in, "adapter", adapter, fn); err != nil {
 		return nil, err
 	}
 
@@ -434,7 +434,7 @@ func (r *AdapterRunner) Dump(in Request) (*Response, error) {
 		assets[key] = ele
 	}
 
-	if err := dumpAssets(in, assets); err != nil {
+	if err := dumpAssets(in, "adapter", assets); err != nil {
 		return nil, err
 	}
 
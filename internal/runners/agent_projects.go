@@ -370,7 +370,7 @@ func (r *AgentProjectRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.agent-project.json", normalizeFilename(name))
 
-	if err := exportAssetFromRequest(in, exported, fn); err != nil {
+	if err := exportAssetFromRequest(in, "agent-project", exported, fn); err != nil {
 		return nil, err
 	}
 
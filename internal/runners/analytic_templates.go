@@ -7,6 +7,9 @@ package runners
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/itential/ipctl/internal/config"
@@ -15,6 +18,7 @@ import (
 	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
 	"github.com/itential/ipctl/pkg/services"
+	"github.com/mitchellh/go-homedir"
 )
 
 type AnalyticTemplateRunner struct {
@@ -200,7 +204,7 @@ func (r *AnalyticTemplateRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.analytic_template.json", name)
 
-	if err := exportAssetFromRequest(in, ct, fn); err != nil {
+	if err := exportAssetFromRequest(in, "analytic_template", ct, fn); err != nil {
 		return nil, err
 	}
 
@@ -276,6 +280,107 @@ func (r *AnalyticTemplateRunner) CopyTo(profile string, in any, replace bool) (a
 	return nil, nil
 }
 
+/*
+******************************************************************************
+Tester Interface
+******************************************************************************
+*/
+
+// analyticTemplateCase is a single set of captured pre and post command
+// output used to test an analytic template
+type analyticTemplateCase struct {
+	Name string
+	Pre  string
+	Post string
+}
+
+// analyticTemplateCaseResult is the result of testing an analytic template
+// against a single case
+type analyticTemplateCaseResult struct {
+	Case   string                              `json:"case"`
+	Passed bool                                `json:"passed"`
+	Result *services.AnalyticTemplateRunResult `json:"result"`
+}
+
+// Test implements the `test analytic-template <name>` command.  It evaluates
+// the analytic template against captured pre and post command output and
+// displays the result of each rule.  When `--dir` is specified, every case
+// in the directory is evaluated.  The response is returned along with an
+// error when one or more rules fail.
+func (r *AnalyticTemplateRunner) Test(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.AnalyticTemplateTestOptions)
+
+	name := in.Args[0]
+
+	var cases []analyticTemplateCase
+
+	if options.Dir != "" {
+		res, err := loadAnalyticTemplateCases(options.Dir)
+		if err != nil {
+			return nil, err
+		}
+		cases = res
+	} else {
+		pre, err := readArgData(options.Pre)
+		if err != nil {
+			return nil, err
+		}
+		post, err := readArgData(options.Post)
+		if err != nil {
+			return nil, err
+		}
+		cases = []analyticTemplateCase{{Name: name, Pre: string(pre), Post: string(post)}}
+	}
+
+	if _, err := r.service.Get(name); err != nil {
+		return nil, err
+	}
+
+	var results []analyticTemplateCaseResult
+
+	for _, ele := range cases {
+		res, err := r.service.Run(name, ele.Pre, ele.Post)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, analyticTemplateCaseResult{
+			Case:   ele.Name,
+			Passed: analyticTemplateFailures(res) == 0,
+			Result: res,
+		})
+	}
+
+	if options.JUnit != "" {
+		b, err := analyticTemplateJUnit(name, results)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := homedir.Expand(options.JUnit)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(dst, b, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	text, failed := analyticTemplateTestOutput(results, options.Dir != "")
+
+	if failed > 0 {
+		return &Response{
+			Text:   text,
+			Object: results,
+		}, fmt.Errorf("analytic template `%s` failed %v rule(s)", name, failed)
+	}
+
+	return &Response{
+		Text:   text,
+		Object: results,
+	}, nil
+}
+
 /*
 ******************************************************************************
 Private functions
@@ -304,3 +409,190 @@ func (r *AnalyticTemplateRunner) importAnalyticTemplate(in services.AnalyticTemp
 
 	return r.service.Import(in)
 }
+
+// loadAnalyticTemplateCases reads the captured output files from path.  Each
+// case is a pair of files named `<case>.pre.<ext>` and `<case>.post.<ext>`.
+func loadAnalyticTemplateCases(path string) ([]analyticTemplateCase, error) {
+	logging.Trace()
+
+	dir, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pre := map[string]string{}
+	post := map[string]string{}
+
+	for _, ele := range entries {
+		if ele.IsDir() {
+			continue
+		}
+
+		fn := ele.Name()
+		base := strings.TrimSuffix(fn, filepath.Ext(fn))
+
+		var target map[string]string
+		var caseName string
+
+		if strings.HasSuffix(base, ".pre") {
+			target, caseName = pre, strings.TrimSuffix(base, ".pre")
+		} else if strings.HasSuffix(base, ".post") {
+			target, caseName = post, strings.TrimSuffix(base, ".post")
+		} else {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, fn))
+		if err != nil {
+			return nil, err
+		}
+
+		target[caseName] = string(b)
+	}
+
+	var names []string
+	for key := range pre {
+		if _, exists := post[key]; !exists {
+			return nil, fmt.Errorf("case `%s` is missing the captured post output", key)
+		}
+		names = append(names, key)
+	}
+
+	for key := range post {
+		if _, exists := pre[key]; !exists {
+			return nil, fmt.Errorf("case `%s` is missing the captured pre output", key)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no test cases found in `%s`", path)
+	}
+
+	sort.Strings(names)
+
+	var cases []analyticTemplateCase
+	for _, ele := range names {
+		cases = append(cases, analyticTemplateCase{Name: ele, Pre: pre[ele], Post: post[ele]})
+	}
+
+	return cases, nil
+}
+
+// analyticTemplateNoResult is reported for a case when the server does not
+// return a result for the analytic template
+const analyticTemplateNoResult = "the server did not return a result"
+
+// analyticTemplateFailures returns the number of rules that failed.  A case
+// without a result counts as a single failure.
+func analyticTemplateFailures(res *services.AnalyticTemplateRunResult) int {
+	if res == nil {
+		return 1
+	}
+
+	var failed int
+	for _, cmd := range res.PrePostCommands {
+		for _, rule := range cmd.Rules {
+			if !rule.Result {
+				failed++
+			}
+		}
+	}
+	return failed
+}
+
+// analyticTemplateRuleName returns a short description of the rule
+func analyticTemplateRuleName(rule services.AnalyticTemplateRuleResult) string {
+	return fmt.Sprintf("%s %q %s %q (%s)", rule.Type, rule.PreRegex, rule.Evaluator, rule.PostRegex, rule.Severity)
+}
+
+// analyticTemplateTestOutput formats the results of the analytic template
+// test.  It returns the formatted text and the number of rules that failed.
+func analyticTemplateTestOutput(results []analyticTemplateCaseResult, batch bool) (string, int) {
+	var output []string
+	var passed, failed int
+
+	indent := ""
+	if batch {
+		indent = "  "
+	}
+
+	for _, res := range results {
+		if batch {
+			output = append(output, fmt.Sprintf("Case: %s", res.Case))
+		}
+
+		if res.Result == nil {
+			output = append(output, fmt.Sprintf("%sFAIL  %s", indent, analyticTemplateNoResult))
+			failed++
+			continue
+		}
+
+		for _, cmd := range res.Result.PrePostCommands {
+			output = append(output, fmt.Sprintf("%s%s -> %s", indent, cmd.PreRawCommand, cmd.PostRawCommand))
+
+			for _, rule := range cmd.Rules {
+				status := "PASS"
+				if rule.Result {
+					passed++
+				} else {
+					status = "FAIL"
+					failed++
+				}
+				output = append(output, fmt.Sprintf("%s  %s  %s", indent, status, analyticTemplateRuleName(rule)))
+			}
+		}
+	}
+
+	output = append(output, "", fmt.Sprintf("%v rule(s) passed, %v rule(s) failed", passed, failed))
+
+	return strings.Join(output, "\n"), failed
+}
+
+// analyticTemplateJUnit encodes the results as JUnit XML with one test suite
+// for each case and one test case for each rule
+func analyticTemplateJUnit(name string, results []analyticTemplateCaseResult) ([]byte, error) {
+	junit := utils.JUnitTestSuites{Name: name}
+
+	for _, res := range results {
+		suite := utils.JUnitTestSuite{Name: res.Case}
+
+		if res.Result == nil {
+			suite.Cases = append(suite.Cases, utils.JUnitTestCase{
+				Name:      res.Case,
+				Classname: name,
+				Failure: &utils.JUnitFailure{
+					Message: analyticTemplateNoResult,
+					Type:    "error",
+				},
+			})
+			junit.AddSuite(suite)
+			continue
+		}
+
+		for _, cmd := range res.Result.PrePostCommands {
+			for _, rule := range cmd.Rules {
+				tc := utils.JUnitTestCase{
+					Name:      fmt.Sprintf("%s: %s", cmd.PreRawCommand, analyticTemplateRuleName(rule)),
+					Classname: name,
+				}
+				if !rule.Result {
+					tc.Failure = &utils.JUnitFailure{
+						Message: fmt.Sprintf("%s rule failed", rule.Evaluator),
+						Type:    rule.Severity,
+						Text:    analyticTemplateRuleName(rule),
+					}
+				}
+				suite.Cases = append(suite.Cases, tc)
+			}
+		}
+
+		junit.AddSuite(suite)
+	}
+
+	return junit.Marshal()
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticTemplateTestBatch(t *testing.T) {
+	runner := NewAnalyticTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/mop/listAnAnalyticTemplate/version-check", `[{"_id": "version-check", "name": "version-check"}]`, 0)
+
+	testlib.AddHandlerToMux("/mop/runAnalyticsTemplate", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Pre  map[string]string `json:"pre"`
+			Post map[string]string `json:"post"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		res := services.AnalyticTemplateRunResult{
+			Name: "version-check",
+			PrePostCommands: []services.AnalyticTemplateCommandResult{{
+				PreRawCommand:  "show version",
+				PostRawCommand: "show version",
+				Rules: []services.AnalyticTemplateRuleResult{{
+					Type:      "regex",
+					Evaluator: "=",
+					Severity:  "error",
+					Result:    body.Pre["response"] == body.Post["response"],
+				}},
+			}},
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(res)
+	})
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"same.pre.txt":    "Version 15.1",
+		"same.post.txt":   "Version 15.1",
+		"change.pre.txt":  "Version 15.1",
+		"change.post.txt": "Version 15.2",
+		"README.md":       "ignored",
+	}
+	for fn, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fn), []byte(data), 0644))
+	}
+
+	report := filepath.Join(t.TempDir(), "report.xml")
+
+	res, err := runner.Test(Request{
+		Args:    []string{"version-check"},
+		Options: &flags.AnalyticTemplateTestOptions{Dir: dir, JUnit: report},
+	})
+
+	assert.Error(t, err)
+	require.NotNil(t, res)
+	assert.Contains(t, res.Text, "Case: change")
+	assert.Contains(t, res.Text, "Case: same")
+	assert.Contains(t, res.Text, "1 rule(s) passed, 1 rule(s) failed")
+
+	b, err := os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `<testsuites name="version-check" tests="2" failures="1">`)
+}
+
+func TestAnalyticTemplateTestNullResult(t *testing.T) {
+	runner := NewAnalyticTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/mop/listAnAnalyticTemplate/version-check", `[{"_id": "version-check", "name": "version-check"}]`, 0)
+	testlib.AddPostResponseToMux("/mop/runAnalyticsTemplate", `null`, http.StatusOK)
+
+	dir := t.TempDir()
+	for _, fn := range []string{"a.pre.txt", "a.post.txt", "b.pre.txt", "b.post.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fn), []byte("Version 15.1"), 0644))
+	}
+
+	report := filepath.Join(t.TempDir(), "report.xml")
+
+	// every case is reported as failed and the batch is not interrupted
+	res, err := runner.Test(Request{
+		Args:    []string{"version-check"},
+		Options: &flags.AnalyticTemplateTestOptions{Dir: dir, JUnit: report},
+	})
+
+	assert.EqualError(t, err, "analytic template `version-check` failed 2 rule(s)")
+	require.NotNil(t, res)
+	assert.Equal(t, "Case: a\n  FAIL  the server did not return a result\n"+
+		"Case: b\n  FAIL  the server did not return a result\n"+
+		"\n0 rule(s) passed, 2 rule(s) failed", res.Text)
+	assert.False(t, res.Object.([]analyticTemplateCaseResult)[0].Passed)
+
+	b, err := os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `<testsuites name="version-check" tests="2" failures="2">`)
+}
+
+func TestLoadAnalyticTemplateCasesMissingPost(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "case1.pre.txt"), []byte("pre"), 0644))
+
+	_, err := loadAnalyticTemplateCases(dir)
+	assert.ErrorContains(t, err, "missing the captured post output")
+}
@@ -0,0 +1,605 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	applyCreated    = "created"
+	applyConfigured = "configured"
+	applyUnchanged  = "unchanged"
+)
+
+// applyKinds lists the asset kinds supported by `apply` in the order they
+// are applied.  Assets are applied before the assets that reference them so
+// adapters come first since roles grant methods on adapters, and workflows
+// come last.
+var applyKinds = []string{
+	"adapter",
+	"role",
+	"template",
+	dependencyTransformation,
+	dependencyJsonForm,
+	dependencyWorkflow,
+}
+
+// applyIgnoredFields returns the fields of the kind that are managed by the
+// server.  They are never copied from a manifest to the server and are not
+// compared.  These are the fields removed by `--canonical` along with the
+// ids, which are always taken from the asset on the server.
+func applyIgnoredFields(kind string) []string {
+	res := []string{"_id", "id"}
+	res = append(res, canonicalVolatileFields...)
+	return append(res, canonicalKindFields[kind]...)
+}
+
+// ApplyRunner implements the `apply` command.
+type ApplyRunner struct {
+	BaseRunner
+}
+
+func NewApplyRunner(c client.Client, cfg config.Provider) *ApplyRunner {
+	return &ApplyRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+	}
+}
+
+// applyManifest is a single asset declared in a manifest file.
+type applyManifest struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec map[string]interface{} `json:"spec"`
+
+	path string
+}
+
+func (m *applyManifest) key() string {
+	return m.Kind + "/" + m.Metadata.Name
+}
+
+// document returns the asset document declared by the manifest.  The name
+// is always taken from the manifest metadata and server managed fields are
+// removed.
+func (m *applyManifest) document() map[string]interface{} {
+	doc := map[string]interface{}{}
+	for key, value := range m.Spec {
+		doc[key] = value
+	}
+	for _, key := range applyIgnoredFields(m.Kind) {
+		delete(doc, key)
+	}
+	doc["name"] = m.Metadata.Name
+	return doc
+}
+
+// appliedAsset is the report for a single applied manifest.
+type appliedAsset struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Path   string   `json:"path"`
+	Result string   `json:"result"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Apply implements the `apply -f <path>` command.  Every manifest found in
+// the specified files and directories is compared with the asset of the
+// same kind and name on the server.  Missing assets are created, assets
+// that differ from the manifest are updated in place and all other assets
+// are left unchanged.
+func (r *ApplyRunner) Apply(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Common.(*flags.ApplyOptions)
+
+	var manifests []*applyManifest
+
+	for _, ele := range options.Filename {
+		res, err := loadApplyManifests(ele)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, res...)
+	}
+
+	if len(manifests) == 0 {
+		return nil, errors.New("no manifests found")
+	}
+
+	manifests, err := orderApplyManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	appliers := r.appliers()
+
+	var report []appliedAsset
+	var output []string
+
+	counts := map[string]int{}
+
+	for _, m := range manifests {
+		result, fields, err := appliers[m.Kind].apply(m, options.DryRun)
+		if err != nil {
+			err = fmt.Errorf("failed to apply %s from `%s`: %w", m.key(), m.path, err)
+			if len(output) == 0 {
+				return nil, err
+			}
+			return &Response{
+				Text:   strings.Join(output, "\n"),
+				Object: report,
+			}, err
+		}
+
+		counts[result]++
+
+		report = append(report, appliedAsset{
+			Kind:   m.Kind,
+			Name:   m.Metadata.Name,
+			Path:   m.path,
+			Result: result,
+			Fields: fields,
+		})
+
+		line := fmt.Sprintf("%s %s", m.key(), result)
+		if options.DryRun && result != applyUnchanged {
+			line += " (dry run)"
+		}
+		output = append(output, line)
+	}
+
+	summary := fmt.Sprintf(
+		"%v created, %v configured, %v unchanged",
+		counts[applyCreated], counts[applyConfigured], counts[applyUnchanged],
+	)
+	if options.DryRun {
+		summary += " (dry run)"
+	}
+
+	output = append(output, "", summary)
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: report,
+	}, nil
+}
+
+// appliers returns the applier for every supported kind.  Each applier
+// loads the assets of its kind from the server at most once.
+func (r *ApplyRunner) appliers() map[string]applier {
+	roles := services.NewRoleService(r.client)
+	adapters := resources.NewAdapterResource(services.NewAdapterService(r.client))
+	templates := resources.NewTemplateResource(services.NewTemplateService(r.client))
+	transformations := resources.NewTransformationResource(services.NewTransformationService(r.client))
+	jsonforms := resources.NewJsonFormResource(services.NewJsonFormService(r.client))
+	workflows := resources.NewWorkflowResource(services.NewWorkflowService(r.client))
+
+	return map[string]applier{
+		"role": &applyResource[services.Role]{
+			getAll: roles.GetAll,
+			match: func(m *applyManifest, role services.Role) bool {
+				provenance, _ := m.Spec["provenance"].(string)
+				return role.Name == m.Metadata.Name && (provenance == "" || role.Provenance == provenance)
+			},
+			create: func(in services.Role) error {
+				_, err := roles.Import(in)
+				return err
+			},
+			update: func(in services.Role) error {
+				_, err := roles.Update(in)
+				return err
+			},
+		},
+		"adapter": &applyResource[services.Adapter]{
+			getAll: adapters.GetAll,
+			match: func(m *applyManifest, adapter services.Adapter) bool {
+				return adapter.Name == m.Metadata.Name
+			},
+			create: func(in services.Adapter) error {
+				_, err := adapters.Import(in)
+				return err
+			},
+			update: func(in services.Adapter) error {
+				_, err := adapters.Update(in)
+				return err
+			},
+		},
+		"template": &applyResource[services.Template]{
+			getAll: templates.GetAll,
+			match: func(m *applyManifest, template services.Template) bool {
+				return template.Name == m.Metadata.Name
+			},
+			create: func(in services.Template) error {
+				_, err := templates.Import(in)
+				return err
+			},
+			update: func(in services.Template) error {
+				_, err := templates.Update(in)
+				return err
+			},
+		},
+		dependencyTransformation: &applyResource[services.Transformation]{
+			getAll: transformations.GetAll,
+			match: func(m *applyManifest, transformation services.Transformation) bool {
+				return transformation.Name == m.Metadata.Name
+			},
+			create: func(in services.Transformation) error {
+				_, err := transformations.Import(in)
+				return err
+			},
+			update: func(in services.Transformation) error {
+				_, err := transformations.Update(in)
+				return err
+			},
+		},
+		dependencyJsonForm: &applyResource[services.JsonForm]{
+			getAll: jsonforms.GetAll,
+			match: func(m *applyManifest, form services.JsonForm) bool {
+				return form.Name == m.Metadata.Name
+			},
+			create: func(in services.JsonForm) error {
+				_, err := jsonforms.Import(in)
+				return err
+			},
+			update: func(in services.JsonForm) error {
+				_, err := jsonforms.Update(in)
+				return err
+			},
+		},
+		dependencyWorkflow: &applyResource[services.Workflow]{
+			getAll: workflows.GetAll,
+			match: func(m *applyManifest, wf services.Workflow) bool {
+				return wf.Name == m.Metadata.Name
+			},
+			create: func(in services.Workflow) error {
+				_, err := workflows.Import(in)
+				return err
+			},
+			update: func(in services.Workflow) error {
+				_, err := workflows.Update(in)
+				return err
+			},
+		},
+	}
+}
+
+// applier creates or updates the asset declared by a manifest.  It returns
+// the result of the operation and the top level fields that were changed
+// when the asset was updated.
+type applier interface {
+	apply(m *applyManifest, dryRun bool) (string, []string, error)
+}
+
+// applyResource implements applier for assets of type T.
+type applyResource[T any] struct {
+	getAll func() ([]T, error)
+	match  func(*applyManifest, T) bool
+	create func(T) error
+	update func(T) error
+
+	items  []T
+	loaded bool
+}
+
+// get returns the asset on the server that matches the manifest or nil if
+// the asset does not exist.
+func (a *applyResource[T]) get(m *applyManifest) (*T, error) {
+	if !a.loaded {
+		items, err := a.getAll()
+		if err != nil {
+			return nil, err
+		}
+		a.items = items
+		a.loaded = true
+	}
+
+	var res *T
+
+	for idx := range a.items {
+		if a.match(m, a.items[idx]) {
+			if res != nil {
+				return nil, fmt.Errorf("found more than one %s named `%s`", m.Kind, m.Metadata.Name)
+			}
+			res = &a.items[idx]
+		}
+	}
+
+	return res, nil
+}
+
+func (a *applyResource[T]) apply(m *applyManifest, dryRun bool) (string, []string, error) {
+	logging.Trace()
+
+	current, err := a.get(m)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if current == nil {
+		var in T
+		if err := utils.ToMap(m.document(), &in); err != nil {
+			return "", nil, err
+		}
+		if !dryRun {
+			if err := a.create(in); err != nil {
+				return "", nil, err
+			}
+		}
+		return applyCreated, nil, nil
+	}
+
+	before, err := toMap(current)
+	if err != nil {
+		return "", nil, err
+	}
+
+	merged, err := toMap(current)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for key, value := range m.document() {
+		merged[key] = value
+	}
+
+	var in T
+	if err := utils.ToMap(merged, &in); err != nil {
+		return "", nil, err
+	}
+
+	after, err := toMap(in)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fields := changedFields(before, after)
+
+	if len(fields) == 0 {
+		return applyUnchanged, nil, nil
+	}
+
+	logging.Info("%s/%s has changes to %s", m.Kind, m.Metadata.Name, strings.Join(fields, ", "))
+
+	if !dryRun {
+		if err := a.update(in); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return applyConfigured, fields, nil
+}
+
+// changedFields returns the sorted list of top level keys with different
+// values in a and b.
+func changedFields(a, b map[string]interface{}) []string {
+	keys := map[string]bool{}
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+
+	var res []string
+	for key := range keys {
+		if !reflect.DeepEqual(a[key], b[key]) {
+			res = append(res, key)
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// applyKind returns the normalized form of a manifest kind.  Kinds are case
+// insensitive and may use dashes or underscores, for instance `JsonForm` or
+// `json-form`.
+func applyKind(kind string) string {
+	return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(kind))
+}
+
+// loadApplyManifests reads every manifest from path.  Path can be a single
+// file or a directory that is searched recursively for files with a .json,
+// .yaml or .yml extension.
+func loadApplyManifests(path string) ([]*applyManifest, error) {
+	logging.Trace()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return readApplyManifests(path)
+	}
+
+	var manifests []*applyManifest
+
+	err = filepath.WalkDir(path, func(fn string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(fn)) {
+		case ".json", ".yaml", ".yml":
+			res, err := readApplyManifests(fn)
+			if err != nil {
+				return err
+			}
+			manifests = append(manifests, res...)
+		}
+		return nil
+	})
+
+	return manifests, err
+}
+
+// readApplyManifests reads the manifests from a single file.  JSON files can
+// hold a single manifest or a list of manifests.  YAML files can hold one or
+// more documents separated by `---`.
+func readApplyManifests(fn string) ([]*applyManifest, error) {
+	logging.Trace()
+
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []interface{}
+
+	if strings.ToLower(filepath.Ext(fn)) == ".json" {
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to decode `%s`: %w", fn, err)
+		}
+		if items, ok := doc.([]interface{}); ok {
+			docs = items
+		} else {
+			docs = append(docs, doc)
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		for {
+			var doc interface{}
+			if err := decoder.Decode(&doc); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, fmt.Errorf("failed to decode `%s`: %w", fn, err)
+			}
+			if doc != nil {
+				docs = append(docs, utils.NormalizeValue(doc))
+			}
+		}
+	}
+
+	var manifests []*applyManifest
+
+	for _, doc := range docs {
+		if _, ok := doc.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("invalid manifest in `%s`, expected an object", fn)
+		}
+
+		var m applyManifest
+		if err := utils.ToMap(doc, &m); err != nil {
+			return nil, fmt.Errorf("invalid manifest in `%s`: %w", fn, err)
+		}
+
+		if m.Kind == "" {
+			return nil, fmt.Errorf("invalid manifest in `%s`, missing required field `kind`", fn)
+		}
+
+		if m.Metadata.Name == "" {
+			return nil, fmt.Errorf("invalid manifest in `%s`, missing required field `metadata.name`", fn)
+		}
+
+		m.Kind = applyKind(m.Kind)
+		m.path = fn
+
+		manifests = append(manifests, &m)
+	}
+
+	return manifests, nil
+}
+
+// orderApplyManifests returns the manifests in the order they must be
+// applied.  Manifests are grouped by kind using the order of applyKinds and
+// workflows are ordered so child workflows are applied before the workflows
+// that run them.  An error is returned for unsupported kinds and for assets
+// declared more than once.
+func orderApplyManifests(manifests []*applyManifest) ([]*applyManifest, error) {
+	logging.Trace()
+
+	rank := map[string]int{}
+	for idx, ele := range applyKinds {
+		rank[ele] = idx
+	}
+
+	seen := map[string]*applyManifest{}
+
+	for _, m := range manifests {
+		if _, exists := rank[m.Kind]; !exists {
+			return nil, fmt.Errorf(
+				"unsupported kind `%s` in `%s`, must be one of %s",
+				m.Kind, m.path, strings.Join(applyKinds, ", "),
+			)
+		}
+		if other, exists := seen[m.key()]; exists {
+			return nil, fmt.Errorf("%s is declared in both `%s` and `%s`", m.key(), other.path, m.path)
+		}
+		seen[m.key()] = m
+	}
+
+	sorted := make([]*applyManifest, len(manifests))
+	copy(sorted, manifests)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if rank[sorted[i].Kind] != rank[sorted[j].Kind] {
+			return rank[sorted[i].Kind] < rank[sorted[j].Kind]
+		}
+		return sorted[i].Metadata.Name < sorted[j].Metadata.Name
+	})
+
+	var res []*applyManifest
+	visited := map[string]bool{}
+
+	var visit func(m *applyManifest)
+	visit = func(m *applyManifest) {
+		if visited[m.key()] {
+			return
+		}
+		visited[m.key()] = true
+
+		if m.Kind == dependencyWorkflow {
+			var wf services.Workflow
+			if err := utils.ToMap(m.document(), &wf); err == nil {
+				for _, ref := range workflowReferences(wf) {
+					if ref.Kind != dependencyWorkflow {
+						continue
+					}
+					if child, exists := seen[dependencyWorkflow+"/"+ref.Value]; exists {
+						visit(child)
+					}
+				}
+			}
+		}
+
+		res = append(res, m)
+	}
+
+	for _, m := range sorted {
+		visit(m)
+	}
+
+	return res, nil
+}
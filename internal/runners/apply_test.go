@@ -0,0 +1,281 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const applyTestManifests = `---
+kind: Workflow
+metadata:
+  name: parent
+spec:
+  description: runs the child workflow
+  tasks:
+    a1b2:
+      name: childJob
+      app: WorkFlowEngine
+      variables:
+        incoming:
+          workflow: child
+---
+kind: workflow
+metadata:
+  name: child
+spec:
+  description: child workflow
+---
+kind: Role
+metadata:
+  name: operator
+spec:
+  provenance: Custom
+  description: operators
+  allowedMethods:
+    - name: getWorkflows
+      provenance: AutomationStudio
+`
+
+// applyTestServer records the requests that change assets on the server.
+type applyTestServer struct {
+	sync.Mutex
+	requests []string
+	updated  map[string]interface{}
+}
+
+func setupApplyMux(t *testing.T) *applyTestServer {
+	server := &applyTestServer{}
+
+	record := func(r *http.Request) map[string]interface{} {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		server.Lock()
+		defer server.Unlock()
+		server.requests = append(server.requests, r.Method+" "+r.URL.Path)
+		return body
+	}
+
+	testlib.AddGetResponseToMux("/authorization/roles", `{
+		"results": [{
+			"_id": "r1",
+			"name": "operator",
+			"provenance": "Custom",
+			"description": "operators",
+			"allowedMethods": [{"name": "getWorkflows", "provenance": "AutomationStudio"}],
+			"allowedViews": []
+		}],
+		"total": 1
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", `{
+		"items": [{
+			"_id": "w1",
+			"name": "parent",
+			"description": "old description",
+			"created": "2024-01-01T00:00:00.000Z",
+			"tasks": {
+				"a1b2": {
+					"name": "childJob",
+					"app": "WorkFlowEngine",
+					"variables": {"incoming": {"workflow": "child"}}
+				}
+			}
+		}],
+		"total": 1
+	}`, 0)
+
+	testlib.AddHandlerToMux("POST /automation-studio/automations/import", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Write([]byte(`{}`))
+	})
+
+	testlib.AddHandlerToMux("PUT /automation-studio/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body := record(r)
+		server.Lock()
+		server.updated = body["update"].(map[string]interface{})
+		server.Unlock()
+		w.Write([]byte(`{}`))
+	})
+
+	return server
+}
+
+func TestApplyRunnerApply(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	server := setupApplyMux(t)
+
+	fn := writeMigrationFile(t, t.TempDir(), "manifests.yaml", applyTestManifests)
+
+	runner := NewApplyRunner(client, testlib.DefaultConfig())
+
+	res, err := runner.Apply(Request{
+		Common: &flags.ApplyOptions{Filename: []string{fn}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "role/operator unchanged\n"+
+		"workflow/child created\n"+
+		"workflow/parent configured\n"+
+		"\n"+
+		"1 created, 1 configured, 1 unchanged", res.Text)
+
+	assert.Equal(t, []string{
+		"POST /automation-studio/automations/import",
+		"PUT /automation-studio/automations/w1",
+	}, server.requests)
+
+	// the workflow is updated in place and keeps its id and server managed
+	// fields
+	assert.Equal(t, "w1", server.updated["_id"])
+	assert.Equal(t, "runs the child workflow", server.updated["description"])
+	assert.Equal(t, "2024-01-01T00:00:00.000Z", server.updated["created"])
+
+	report := res.Object.([]appliedAsset)
+	require.Len(t, report, 3)
+	assert.Equal(t, []string{"description"}, report[2].Fields)
+}
+
+func TestApplyRunnerApplyServerFields(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	server := setupApplyMux(t)
+
+	// a manifest made from an export includes the server managed fields of
+	// the source server, they are ignored so the workflow is unchanged
+	fn := writeMigrationFile(t, t.TempDir(), "manifests.yaml", `---
+kind: workflow
+metadata:
+  name: parent
+spec:
+  _id: other
+  description: old description
+  created: "2023-06-01T00:00:00.000Z"
+  createdVersion: "5.0.0"
+  lastUpdatedVersion: "5.0.1"
+  lastUpdateBy: admin
+  tasks:
+    a1b2:
+      name: childJob
+      app: WorkFlowEngine
+      variables:
+        incoming:
+          workflow: child
+`)
+
+	runner := NewApplyRunner(client, testlib.DefaultConfig())
+
+	res, err := runner.Apply(Request{
+		Common: &flags.ApplyOptions{Filename: []string{fn}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "workflow/parent unchanged\n\n0 created, 0 configured, 1 unchanged", res.Text)
+	assert.Empty(t, server.requests)
+
+	manifest := &applyManifest{Kind: dependencyTransformation, Spec: map[string]interface{}{"version": "2023.2", "steps": []interface{}{}}}
+	manifest.Metadata.Name = "jst"
+	assert.Equal(t, map[string]interface{}{"name": "jst", "steps": []interface{}{}}, manifest.document())
+}
+
+func TestApplyRunnerApplyDryRun(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	server := setupApplyMux(t)
+
+	fn := writeMigrationFile(t, t.TempDir(), "manifests.yaml", applyTestManifests)
+
+	runner := NewApplyRunner(client, testlib.DefaultConfig())
+
+	res, err := runner.Apply(Request{
+		Common: &flags.ApplyOptions{Filename: []string{fn}, DryRun: true},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, res.Text, "workflow/child created (dry run)")
+	assert.Contains(t, res.Text, "1 created, 1 configured, 1 unchanged (dry run)")
+	assert.Empty(t, server.requests)
+}
+
+func TestLoadApplyManifests(t *testing.T) {
+	dir := t.TempDir()
+
+	writeMigrationFile(t, dir, "roles.yaml", "kind: role\nmetadata:\n  name: admin\n---\nkind: role\nmetadata:\n  name: operator\n")
+	writeMigrationFile(t, dir, "nested/forms.json", `[{"kind": "JsonForm", "metadata": {"name": "form"}, "spec": {}}]`)
+	writeMigrationFile(t, dir, "nested/jst.json", `{"kind": "transformation", "metadata": {"name": "jst"}, "spec": {"incoming": []}}`)
+	writeMigrationFile(t, dir, "README.md", "kind: role")
+
+	manifests, err := loadApplyManifests(dir)
+	require.NoError(t, err)
+
+	var keys []string
+	for _, m := range manifests {
+		keys = append(keys, m.key())
+	}
+
+	assert.Equal(t, []string{"jsonform/form", "transformation/jst", "role/admin", "role/operator"}, keys)
+	assert.Equal(t, filepath.Join(dir, "nested", "jst.json"), manifests[1].path)
+	assert.Equal(t, map[string]interface{}{"name": "jst", "incoming": []interface{}{}}, manifests[1].document())
+
+	fn := writeMigrationFile(t, dir, "invalid.yaml", "kind: role\nspec: {}\n")
+	_, err = loadApplyManifests(fn)
+	assert.EqualError(t, err, "invalid manifest in `"+fn+"`, missing required field `metadata.name`")
+}
+
+func TestOrderApplyManifests(t *testing.T) {
+	manifest := func(kind, name, path string) *applyManifest {
+		m := &applyManifest{Kind: kind, path: path}
+		m.Metadata.Name = name
+		return m
+	}
+
+	parent := manifest("workflow", "a-parent", "parent.yaml")
+	parent.Spec = map[string]interface{}{
+		"tasks": map[string]interface{}{
+			"a1b2": map[string]interface{}{
+				"name":      "childJob",
+				"app":       "WorkFlowEngine",
+				"variables": map[string]interface{}{"incoming": map[string]interface{}{"workflow": "z-child"}},
+			},
+		},
+	}
+
+	res, err := orderApplyManifests([]*applyManifest{
+		parent,
+		manifest("workflow", "z-child", "child.yaml"),
+		manifest("template", "show version", "template.yaml"),
+		manifest("adapter", "local", "adapter.yaml"),
+	})
+	require.NoError(t, err)
+
+	var keys []string
+	for _, m := range res {
+		keys = append(keys, m.key())
+	}
+
+	assert.Equal(t, []string{"adapter/local", "template/show version", "workflow/z-child", "workflow/a-parent"}, keys)
+
+	_, err = orderApplyManifests([]*applyManifest{manifest("device", "router", "device.yaml")})
+	assert.EqualError(t, err, "unsupported kind `device` in `device.yaml`, must be one of adapter, role, template, transformation, jsonform, workflow")
+
+	_, err = orderApplyManifests([]*applyManifest{
+		manifest("adapter", "local", "one.yaml"),
+		manifest("adapter", "local", "two.yaml"),
+	})
+	assert.EqualError(t, err, "adapter/local is declared in both `one.yaml` and `two.yaml`")
+}
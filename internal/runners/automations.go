@@ -210,6 +210,13 @@ func (r *AutomationRunner) Clear(in Request) (*Response, error) {
 func (r *AutomationRunner) Copy(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.AutomationCopyOptions
+	utils.LoadObject(in.Options, &options)
+
+	if options.WithDependencies {
+		return copyDependencies(in, dependencyAutomation, r.config)
+	}
+
 	res, err := Copy(CopyRequest{Request: in, Type: "automation"}, r)
 	if err != nil {
 		return nil, err
@@ -284,7 +291,7 @@ func (r *AutomationRunner) Import(in Request) (*Response, error) {
 
 	var automation services.Automation
 
-	if err := importUnmarshalFromRequest(in, &automation); err != nil {
+	if err := importExpandedFromRequest(in, &automation, nil, "triggers"); err != nil {
 		return nil, err
 	}
 
@@ -309,8 +316,28 @@ func (r *AutomationRunner) Import(in Request) (*Response, error) {
 func (r *AutomationRunner) Export(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.AutomationExportOptions
+	utils.LoadObject(in.Options, &options)
+
 	name := in.Args[0]
 
+	if options.WithDependencies {
+		root, err := newDependencyResolver(r.client).Resolve(dependencyAutomation, name)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest, err := exportDependencies(in, root)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Text:   fmt.Sprintf("Successfully exported automation `%s` with %v dependent asset(s)", name, len(manifest.Assets)-1),
+			Object: manifest,
+		}, nil
+	}
+
 	automation, err := r.resource.GetByName(name)
 	if err != nil {
 		return nil, err
@@ -323,7 +350,26 @@ func (r *AutomationRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.automation.json", name)
 
-	if err := exportAssetFromRequest(in, res, fn); err != nil {
+	if options.Expand {
+		expanded, err := newExpandedAsset(in, "automation", res, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded.split("triggers", func(idx int, ele any) string {
+			trigger := fmt.Sprint(idx)
+			if m, ok := ele.(map[string]interface{}); ok {
+				if s, ok := m["name"].(string); ok && s != "" {
+					trigger = s
+				}
+			}
+			return fmt.Sprintf("%s.trigger.%s.json", name, trigger)
+		})
+
+		if err := exportExpandedFromRequest(in, expanded); err != nil {
+			return nil, err
+		}
+	} else if err := exportAssetFromRequest(in, "automation", res, fn); err != nil {
 		return nil, err
 	}
 
@@ -358,7 +404,7 @@ func (r *AutomationRunner) Dump(in Request) (*Response, error) {
 		assets[key] = automation
 	}
 
-	if err := dumpAssets(in, assets); err != nil {
+	if err := dumpAssets(in, "automation", assets); err != nil {
 		return nil, err
 	}
 
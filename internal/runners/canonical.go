@@ -0,0 +1,131 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"strings"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+)
+
+// canonicalVolatileFields are the server managed fields that change every
+// time an asset is saved or copied between servers.  They are removed from
+// every asset written with the `--canonical` flag.
+var canonicalVolatileFields = []string{
+	"_id",
+	"created",
+	"createdBy",
+	"created_by",
+	"createdVersion",
+	"lastUpdated",
+	"lastUpdatedBy",
+	"lastUpdateBy",
+	"last_updated",
+	"last_updated_by",
+	"lastUpdatedVersion",
+	"updated",
+}
+
+// canonicalKindFields are the additional fields removed for each asset kind.
+var canonicalKindFields = map[string][]string{
+	"jsonform":       {"version"},
+	"transformation": {"version"},
+	"automation":     {"componentId"},
+}
+
+// canonicalKeepFields are the volatile fields that must be kept for an asset
+// kind because they are used to link the parts of the asset together.
+// Automation triggers reference the automation by its id, project
+// components reference their documents by id and workflow tasks reference
+// transformations and forms by id so those ids are kept.
+var canonicalKeepFields = map[string][]string{
+	"automation":     {"_id"},
+	"jsonform":       {"id"},
+	"project":        {"_id"},
+	"transformation": {"_id"},
+}
+
+// canonicalNestedFields lists the fields of an asset kind that hold nested
+// documents.  The volatile fields are removed from each of the nested
+// documents as well.
+var canonicalNestedFields = map[string][]string{
+	"automation": {"triggers"},
+	"project":    {"components.document"},
+}
+
+// canonicalRequested returns true if the request asks for canonical output.
+func canonicalRequested(in Request) bool {
+	if c, ok := in.Common.(flags.Canonicalizer); ok {
+		return c.GetCanonical()
+	}
+	return false
+}
+
+// canonicalAssetFromRequest returns the canonical form of an asset of the
+// given kind when the request asks for canonical output.  Otherwise the
+// asset is returned unchanged.
+func canonicalAssetFromRequest(in Request, kind string, asset any) (any, error) {
+	if !canonicalRequested(in) {
+		return asset, nil
+	}
+	return canonicalAsset(kind, asset)
+}
+
+// canonicalAsset returns the canonical form of an asset of the given kind.
+// The asset is converted to a map so keys are always written in sorted
+// order and the server managed fields are removed.  Importing a canonical
+// asset works the same as importing a regular export because the server
+// assigns the removed fields on import and the ids other assets reference
+// are kept.
+func canonicalAsset(kind string, asset any) (map[string]interface{}, error) {
+	logging.Trace()
+
+	document, err := toMap(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalStrip(document, kind)
+
+	for _, ele := range canonicalNestedFields[kind] {
+		canonicalStripPath(document, strings.Split(ele, "."), kind)
+	}
+
+	return document, nil
+}
+
+// canonicalStrip removes the volatile fields and the fields for the kind
+// from the document.
+func canonicalStrip(document map[string]interface{}, kind string) {
+	keep := map[string]bool{}
+	for _, ele := range canonicalKeepFields[kind] {
+		keep[ele] = true
+	}
+
+	for _, ele := range append(canonicalVolatileFields, canonicalKindFields[kind]...) {
+		if !keep[ele] {
+			delete(document, ele)
+		}
+	}
+}
+
+// canonicalStripPath walks the path of keys in the value and strips the
+// volatile fields from every document found at the end of the path.  Lists
+// found along the path are walked element by element.
+func canonicalStripPath(value any, path []string, kind string) {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, ele := range v {
+			canonicalStripPath(ele, path, kind)
+		}
+	case map[string]interface{}:
+		if len(path) == 0 {
+			canonicalStrip(v, kind)
+			return
+		}
+		canonicalStripPath(v[path[0]], path[1:], kind)
+	}
+}
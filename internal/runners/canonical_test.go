@@ -0,0 +1,242 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalAsset(t *testing.T) {
+	document, err := canonicalAsset("workflow", services.Workflow{
+		Id:                 "abc123",
+		Name:               "test",
+		Created:            "2024-01-01T00:00:00Z",
+		CreatedVersion:     "6.0.0",
+		LastUpdated:        "2024-02-01T00:00:00Z",
+		LastUpdatedVersion: "6.0.1",
+		CreatedBy:          map[string]interface{}{"username": "admin"},
+		Tasks:              map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", document["name"])
+	for _, ele := range []string{"_id", "created", "createdVersion", "last_updated", "lastUpdatedVersion", "created_by"} {
+		assert.NotContains(t, document, ele)
+	}
+
+	// workflow tasks reference forms and transformations by id
+	document, err = canonicalAsset("jsonform", services.JsonForm{Id: "abc123", Name: "test", Version: "2023.2"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", document["id"])
+	assert.NotContains(t, document, "version")
+
+	document, err = canonicalAsset("transformation", services.Transformation{Id: "def456", Name: "test", Version: "2023.2"})
+	require.NoError(t, err)
+	assert.Equal(t, "def456", document["_id"])
+	assert.NotContains(t, document, "version")
+}
+
+func TestCanonicalAssetNested(t *testing.T) {
+	res, err := canonicalAsset("automation", map[string]interface{}{
+		"_id":         "abc123",
+		"componentId": "def456",
+		"created":     "2024-01-01T00:00:00Z",
+		"triggers": []interface{}{
+			map[string]interface{}{"_id": "t1", "actionId": "abc123", "lastUpdated": "2024-01-01T00:00:00Z"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"_id": "abc123",
+		"triggers": []interface{}{
+			map[string]interface{}{"_id": "t1", "actionId": "abc123"},
+		},
+	}, res)
+
+	res, err = canonicalAsset("project", map[string]interface{}{
+		"_id":  "p1",
+		"name": "test",
+		"components": []interface{}{
+			map[string]interface{}{
+				"reference": "w1",
+				"document":  map[string]interface{}{"_id": "w1", "name": "wf", "last_updated": "2024-01-01T00:00:00Z"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	components := res["components"].([]interface{})
+	assert.Equal(t, map[string]interface{}{"_id": "w1", "name": "wf"}, components[0].(map[string]interface{})["document"])
+}
+
+func TestExportAssetsCanonical(t *testing.T) {
+	dir := t.TempDir()
+
+	asset := map[string]interface{}{
+		"name":     "test",
+		"_id":      "abc123",
+		"created":  "2024-01-01T00:00:00Z",
+		"workflow": map[string]interface{}{"created": "kept"},
+	}
+
+	err := exportAssets(Request{
+		Common: &flags.AssetDumpCommon{Path: dir, Canonical: true},
+	}, "template", map[string]interface{}{"test.template.json": asset})
+	require.NoError(t, err)
+
+	b, err := os.ReadFile(filepath.Join(dir, "test.template.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n    \"name\": \"test\",\n    \"workflow\": {\n        \"created\": \"kept\"\n    }\n}", string(b))
+}
+
+func TestExpandProjectCanonical(t *testing.T) {
+	dir := t.TempDir()
+
+	project := sampleExpandableProject()
+	project.Created = "2024-01-01T00:00:00Z"
+	project.LastUpdated = "2024-01-02T00:00:00Z"
+	project.Components[1].Document["_id"] = "ref-tf"
+	project.Components[1].Document["created"] = "2024-01-01T00:00:00Z"
+
+	err := expandProject(Request{
+		Common: &flags.AssetExportCommon{Path: dir, Canonical: true},
+	}, project, dir)
+	require.NoError(t, err)
+
+	main := readMigrationFile(t, filepath.Join(dir, "RoundTripProject.project.json"))
+	assert.Equal(t, "proj-id", main["_id"])
+	assert.NotContains(t, main, "created")
+	assert.NotContains(t, main, "lastUpdated")
+
+	// component documents keep the id the project references them by
+	document := readMigrationFile(t, filepath.Join(dir, "Sample Transformation.transformation.json"))
+	assert.Equal(t, map[string]interface{}{"_id": "ref-tf", "name": "Sample Transformation"}, document)
+}
+
+func TestRoleExportCanonical(t *testing.T) {
+	runner := NewRoleRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/authorization/roles", `{
+		"results": [{"_id": "r1", "name": "operator", "provenance": "Custom"}],
+		"total": 1
+	}`, 0)
+	testlib.AddGetResponseToMux("/authorization/roles/r1", `{
+		"_id": "r1",
+		"name": "operator",
+		"provenance": "Custom",
+		"description": "operators",
+		"allowedMethods": [],
+		"allowedViews": []
+	}`, 0)
+
+	dir := t.TempDir()
+
+	_, err := runner.Export(Request{
+		Args:    []string{"operator"},
+		Common:  &flags.AssetExportCommon{Path: dir, Canonical: true},
+		Options: &flags.RoleExportOptions{},
+	})
+	require.NoError(t, err)
+
+	b, err := os.ReadFile(filepath.Join(dir, "operator.role.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n    \"allowedMethods\": [],\n    \"allowedViews\": [],\n    \"description\": \"operators\",\n    \"name\": \"operator\",\n    \"provenance\": \"Custom\"\n}", string(b))
+}
+
+func TestTransformationCanonicalRoundTrip(t *testing.T) {
+	runner := NewTransformationRunner(testlib.Setup(), testlib.DefaultConfig())
+
+	testlib.AddGetResponseToMux("/transformations", `{
+		"results": [{
+			"_id": "t1",
+			"name": "hostname",
+			"incoming": [],
+			"outgoing": [],
+			"functions": [],
+			"steps": [],
+			"created": "2024-01-01T00:00:00Z",
+			"lastUpdated": "2024-01-02T00:00:00Z",
+			"version": "2023.2"
+		}],
+		"total": 1
+	}`, 0)
+
+	dir := t.TempDir()
+
+	_, err := runner.Export(Request{
+		Args:    []string{"hostname"},
+		Common:  &flags.AssetExportCommon{Path: dir, Canonical: true},
+		Options: &flags.TransformationExportOptions{},
+	})
+	require.NoError(t, err)
+	testlib.Teardown()
+
+	// import the canonical file into a server that does not have the
+	// transformation, workflows referencing it must still find it by id
+	runner = NewTransformationRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/transformations", `{"results": [], "total": 0}`, 0)
+
+	var imported map[string]interface{}
+	testlib.AddHandlerToMux("POST /transformations/import", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&imported))
+		w.Write([]byte(`{"_id": "t1", "name": "hostname"}`))
+	})
+
+	_, err = runner.Import(Request{
+		Args:   []string{filepath.Join(dir, "hostname.transformation.json")},
+		Common: &flags.AssetImportCommon{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "t1", imported["_id"])
+	assert.NotContains(t, imported, "created")
+	assert.NotContains(t, imported, "version")
+}
+
+func TestAutomationExpandCanonical(t *testing.T) {
+	automation := map[string]interface{}{
+		"_id":         "a1",
+		"name":        "nightly",
+		"componentId": "w1",
+		"created":     "2024-01-01T00:00:00Z",
+		"triggers": []interface{}{
+			map[string]interface{}{"_id": "t1", "name": "daily", "actionId": "a1", "lastUpdated": "2024-01-01T00:00:00Z"},
+		},
+	}
+
+	expanded, err := newExpandedAsset(Request{
+		Common: &flags.AssetExportCommon{Canonical: true},
+	}, "automation", automation, "nightly.automation.json")
+	require.NoError(t, err)
+
+	expanded.split("triggers", func(idx int, ele any) string {
+		return "nightly.trigger.daily.json"
+	})
+
+	// triggers written to their own files keep the ids the same as the
+	// triggers of a regular canonical export
+	assert.Equal(t, map[string]interface{}{
+		"nightly.automation.json": map[string]interface{}{
+			"_id":               "a1",
+			"name":              "nightly",
+			"triggersFilenames": []interface{}{"nightly.trigger.daily.json"},
+		},
+		"nightly.trigger.daily.json": map[string]interface{}{"_id": "t1", "name": "daily", "actionId": "a1"},
+	}, expanded.assets())
+}
@@ -14,17 +14,20 @@ import (
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
 	"github.com/itential/ipctl/pkg/services"
 )
 
 type CommandTemplateRunner struct {
 	service *services.CommandTemplateService
+	groups  resources.DeviceGroupResourcer
 	BaseRunner
 }
 
 func NewCommandTemplateRunner(c client.Client, cfg config.Provider) *CommandTemplateRunner {
 	return &CommandTemplateRunner{
 		service:    services.NewCommandTemplateService(c),
+		groups:     resources.NewDeviceGroupResource(services.NewDeviceGroupService(c)),
 		BaseRunner: NewBaseRunner(c, cfg),
 	}
 }
@@ -172,7 +175,7 @@ func (r *CommandTemplateRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.command_template.json", name)
 
-	if err := exportAssetFromRequest(in, ct, fn); err != nil {
+	if err := exportAssetFromRequest(in, "command_template", ct, fn); err != nil {
 		return nil, err
 	}
 
@@ -246,6 +249,64 @@ func (r *CommandTemplateRunner) CopyTo(profile string, in any, replace bool) (an
 	return nil, nil
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Executor Interface
+//
+
+// Run implements the `run command-template <name>` command.  It runs the
+// command template against the devices and displays the result of each rule
+// for every command and device.  The response is returned along with an
+// error when one or more rules fail.
+func (r *CommandTemplateRunner) Run(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.CommandTemplateRunOptions)
+
+	name := in.Args[0]
+
+	devices := options.Devices
+
+	if options.Group != "" {
+		group, err := r.groups.GetByName(options.Group)
+		if err != nil {
+			return nil, err
+		}
+		devices = group.Devices
+	}
+
+	if len(devices) == 0 {
+		return nil, errors.New("no devices to run the command template against")
+	}
+
+	vars, err := readArgVariables(options.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.service.Get(name); err != nil {
+		return nil, err
+	}
+
+	res, err := r.service.Run(name, devices, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	text, failed := commandTemplateRunOutput(res, devices)
+
+	if failed > 0 {
+		return &Response{
+			Text:   text,
+			Object: res,
+		}, fmt.Errorf("command template `%s` failed %v rule(s)", name, failed)
+	}
+
+	return &Response{
+		Text:   text,
+		Object: res,
+	}, nil
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Private functions
 //
@@ -272,3 +333,51 @@ func (r *CommandTemplateRunner) importCommandTemplate(in services.CommandTemplat
 
 	return r.service.Import(in)
 }
+
+// commandTemplateRunOutput formats the results of a command template run
+// grouped by device and command.  It returns the formatted text and the
+// number of rules that failed.
+func commandTemplateRunOutput(res *services.CommandTemplateRunResult, devices []string) (string, int) {
+	var output []string
+	var passed, failed int
+
+	for _, device := range devices {
+		output = append(output, fmt.Sprintf("Device: %s", device))
+
+		var found bool
+
+		for _, cmd := range res.CommandsResults {
+			if cmd.Device != device {
+				continue
+			}
+			found = true
+
+			command := cmd.Evaluated
+			if command == "" {
+				command = cmd.Raw
+			}
+
+			output = append(output, fmt.Sprintf("  %s", command))
+
+			for _, rule := range cmd.Rules {
+				status := "PASS"
+				if rule.Result {
+					passed++
+				} else {
+					status = "FAIL"
+					failed++
+				}
+				output = append(output, fmt.Sprintf("    %s  %s %q (%s)", status, rule.Eval, rule.Rule, rule.Severity))
+			}
+		}
+
+		if !found {
+			output = append(output, "  FAIL  no results returned for device")
+			failed++
+		}
+	}
+
+	output = append(output, "", fmt.Sprintf("%v rule(s) passed, %v rule(s) failed", passed, failed))
+
+	return strings.Join(output, "\n"), failed
+}
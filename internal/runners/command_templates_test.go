@@ -0,0 +1,41 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"testing"
+
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandTemplateRunOutput(t *testing.T) {
+	res := &services.CommandTemplateRunResult{
+		CommandsResults: []services.CommandTemplateCommandResult{
+			{
+				Raw:       "show version",
+				Evaluated: "show version",
+				Device:    "rtr1",
+				Rules: []services.CommandTemplateRuleResult{
+					{Rule: "IOS", Eval: "contains", Severity: "error", Result: true},
+					{Rule: "15.2", Eval: "contains", Severity: "warning", Result: false},
+				},
+			},
+		},
+	}
+
+	text, failed := commandTemplateRunOutput(res, []string{"rtr1"})
+
+	assert.Equal(t, 1, failed)
+	assert.Contains(t, text, "Device: rtr1")
+	assert.Contains(t, text, `PASS  contains "IOS" (error)`)
+	assert.Contains(t, text, `FAIL  contains "15.2" (warning)`)
+	assert.Contains(t, text, "1 rule(s) passed, 1 rule(s) failed")
+
+	text, failed = commandTemplateRunOutput(res, []string{"rtr1", "rtr2"})
+
+	assert.Equal(t, 2, failed)
+	assert.Contains(t, text, "no results returned for device")
+}
@@ -0,0 +1,333 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/mitchellh/go-homedir"
+)
+
+// compliancePollInterval is the amount of time to wait between checks for
+// the status of a compliance run
+var compliancePollInterval = 2 * time.Second
+
+type ComplianceRunner struct {
+	BaseRunner
+	service *services.GoldenConfigService
+	groups  resources.DeviceGroupResourcer
+}
+
+// complianceResult is the pass or fail result of a compliance check for a
+// single device
+type complianceResult struct {
+	Device     string                     `json:"device"`
+	Status     string                     `json:"status"`
+	Errors     int                        `json:"errors"`
+	Warnings   int                        `json:"warnings"`
+	Passes     int                        `json:"passes"`
+	Violations []services.ComplianceIssue `json:"violations"`
+}
+
+// complianceReport is the full report for a compliance run
+type complianceReport struct {
+	Tree    string             `json:"tree"`
+	Version string             `json:"version"`
+	Node    string             `json:"node"`
+	BatchId string             `json:"batchId"`
+	Passed  int                `json:"passed"`
+	Failed  int                `json:"failed"`
+	Results []complianceResult `json:"results"`
+}
+
+func NewComplianceRunner(client client.Client, cfg config.Provider) *ComplianceRunner {
+	return &ComplianceRunner{
+		BaseRunner: NewBaseRunner(client, cfg),
+		service:    services.NewGoldenConfigService(client),
+		groups:     resources.NewDeviceGroupResource(services.NewDeviceGroupService(client)),
+	}
+}
+
+/*
+*******************************************************************************
+Executor interface
+*******************************************************************************
+*/
+
+// Run implements the `run compliance ...` command.  It starts a compliance
+// check for a golden configuration tree node, waits for the check to
+// complete and reports the results for each device.  The response is
+// returned along with an error when one or more devices are not compliant.
+func (r *ComplianceRunner) Run(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.ComplianceRunOptions)
+
+	switch options.ReportFormat {
+	case "json", "csv", "junit":
+	default:
+		return nil, fmt.Errorf("unsupported report format `%s`", options.ReportFormat)
+	}
+
+	tree, err := r.service.GetByName(options.Tree)
+	if err != nil {
+		return nil, err
+	}
+
+	version := options.Version
+	if version == "" {
+		if len(tree.Versions) == 0 {
+			return nil, fmt.Errorf("gctree `%s` does not have any versions", tree.Name)
+		}
+		version = tree.Versions[len(tree.Versions)-1]
+	}
+
+	devices := options.Devices
+	if options.Group != "" {
+		group, err := r.groups.GetByName(options.Group)
+		if err != nil {
+			return nil, err
+		}
+		if len(group.Devices) == 0 {
+			return nil, fmt.Errorf("device group `%s` does not have any devices", group.Name)
+		}
+		devices = group.Devices
+	}
+
+	batchId, err := r.service.RunCompliance(services.ComplianceRun{
+		TreeId:   tree.Id,
+		Version:  version,
+		NodePath: options.Node,
+		Devices:  devices,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := r.waitForCompliance(batchId, time.Duration(options.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	report := complianceReport{
+		Tree:    tree.Name,
+		Version: version,
+		Node:    options.Node,
+		BatchId: batchId,
+	}
+
+	for _, ele := range reports {
+		details, err := r.service.GetComplianceReport(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := complianceResult{
+			Device:   ele.DeviceName,
+			Status:   "pass",
+			Errors:   details.Errors,
+			Warnings: details.Warnings,
+			Passes:   details.Passes,
+		}
+
+		for _, issue := range details.Issues {
+			if issue.Severity == "error" {
+				res.Violations = append(res.Violations, issue)
+			}
+		}
+
+		if res.Errors > 0 || len(res.Violations) > 0 || ele.Status == "error" {
+			res.Status = "fail"
+			report.Failed++
+		} else {
+			report.Passed++
+		}
+
+		report.Results = append(report.Results, res)
+	}
+
+	if options.Report != "" {
+		if err := writeComplianceReport(report, options.Report, options.ReportFormat); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &Response{
+		Text:   complianceReportText(report),
+		Object: report,
+	}
+
+	if report.Failed > 0 {
+		return resp, fmt.Errorf(
+			"compliance check failed for %v of %v device(s)",
+			report.Failed, len(report.Results),
+		)
+	}
+
+	return resp, nil
+}
+
+// waitForCompliance polls the compliance reports for the batch until every
+// report has completed or the timeout is reached.
+func (r *ComplianceRunner) waitForCompliance(batchId string, timeout time.Duration) ([]services.ComplianceReport, error) {
+	logging.Trace()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		reports, err := r.service.GetComplianceReports(batchId)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(reports) > 0 && complianceReportsDone(reports) {
+			return reports, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errors.New("timed out waiting for the compliance check to complete")
+		}
+
+		logging.Debug("waiting for compliance batch `%s` to complete", batchId)
+		time.Sleep(compliancePollInterval)
+	}
+}
+
+// complianceReportsDone returns true when none of the reports are still
+// pending or running.
+func complianceReportsDone(reports []services.ComplianceReport) bool {
+	for _, ele := range reports {
+		switch ele.Status {
+		case "", "pending", "queued", "running":
+			return false
+		}
+	}
+	return true
+}
+
+// complianceReportText returns the human readable output for the report
+// showing the status of each device and the lines that violate the tree.
+func complianceReportText(report complianceReport) string {
+	var output []string
+
+	for _, ele := range report.Results {
+		output = append(output, fmt.Sprintf("%s: %s", ele.Device, strings.ToUpper(ele.Status)))
+		for _, v := range ele.Violations {
+			line := v.Line
+			if line == "" {
+				line = v.Message
+			}
+			output = append(output, fmt.Sprintf("  - [%s] %s", v.Type, line))
+		}
+	}
+
+	output = append(output, "", fmt.Sprintf(
+		"%v device(s) passed, %v device(s) failed",
+		report.Passed, report.Failed,
+	))
+
+	return strings.Join(output, "\n")
+}
+
+// writeComplianceReport encodes the report using the specified format and
+// writes it to path.  Supported formats are `json`, `csv` and `junit`.
+func writeComplianceReport(report complianceReport, path, format string) error {
+	logging.Trace()
+
+	var b []byte
+	var err error
+
+	switch format {
+	case "json":
+		b, err = json.MarshalIndent(report, "", "    ")
+	case "csv":
+		b, err = complianceReportCsv(report)
+	case "junit":
+		b, err = complianceReportJUnit(report)
+	default:
+		err = fmt.Errorf("unsupported report format `%s`", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	dst, err := homedir.Expand(path)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, b, 0644)
+}
+
+// complianceReportCsv encodes the report as CSV with one row for each
+// violation.  Devices without violations are written as a single row.
+func complianceReportCsv(report complianceReport) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{{"device", "status", "errors", "warnings", "type", "severity", "message", "line"}}
+
+	for _, ele := range report.Results {
+		common := []string{ele.Device, ele.Status, strconv.Itoa(ele.Errors), strconv.Itoa(ele.Warnings)}
+		if len(ele.Violations) == 0 {
+			rows = append(rows, append(common, "", "", "", ""))
+		}
+		for _, v := range ele.Violations {
+			row := append([]string{}, common...)
+			rows = append(rows, append(row, v.Type, v.Severity, v.Message, v.Line))
+		}
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// complianceReportJUnit encodes the report as JUnit XML with one test case
+// for each device.
+func complianceReportJUnit(report complianceReport) ([]byte, error) {
+	suite := utils.JUnitTestSuite{Name: fmt.Sprintf("%s:%s", report.Tree, report.Node)}
+
+	for _, ele := range report.Results {
+		tc := utils.JUnitTestCase{Name: ele.Device, Classname: report.Tree}
+
+		if ele.Status == "fail" {
+			var lines []string
+			for _, v := range ele.Violations {
+				lines = append(lines, fmt.Sprintf("[%s] %s %s", v.Type, v.Message, v.Line))
+			}
+			tc.Failure = &utils.JUnitFailure{
+				Message: fmt.Sprintf("%v compliance error(s)", ele.Errors),
+				Type:    "compliance",
+				Text:    strings.Join(lines, "\n"),
+			}
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	junit := utils.JUnitTestSuites{Name: "compliance"}
+	junit.AddSuite(suite)
+
+	return junit.Marshal()
+}
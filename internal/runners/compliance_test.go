@@ -0,0 +1,87 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplianceRunWithViolations(t *testing.T) {
+	runner := NewComplianceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/configs",
+		`[{"id": "tree1", "name": "ios", "versions": ["initial", "v2"]}]`,
+		0,
+	)
+	testlib.AddPostResponseToMux("/configuration_manager/compliance_reports/run", `{"batchId": "batch1"}`, 200)
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/compliance_reports/batch",
+		`[{"id": "r1", "deviceName": "rtr1", "status": "complete"}, {"id": "r2", "deviceName": "rtr2", "status": "complete"}]`,
+		200,
+	)
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/compliance_reports/details/r1",
+		`{"id": "r1", "deviceName": "rtr1", "passes": 4}`,
+		0,
+	)
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/compliance_reports/details/r2",
+		`{"id": "r2", "deviceName": "rtr2", "errors": 1, "issues": [{"type": "missing", "severity": "error", "message": "required line missing", "line": "ntp server 10.0.0.1"}]}`,
+		0,
+	)
+
+	report := filepath.Join(t.TempDir(), "report.xml")
+
+	res, err := runner.Run(Request{
+		Options: &flags.ComplianceRunOptions{
+			Tree:         "ios",
+			Node:         "base",
+			Devices:      []string{"rtr1", "rtr2"},
+			Report:       report,
+			ReportFormat: "junit",
+			Timeout:      1,
+		},
+	})
+
+	assert.Error(t, err)
+	require.NotNil(t, res)
+
+	obj := res.Object.(complianceReport)
+	assert.Equal(t, "v2", obj.Version)
+	assert.Equal(t, 1, obj.Passed)
+	assert.Equal(t, 1, obj.Failed)
+	assert.Contains(t, res.Text, "rtr2: FAIL")
+	assert.Contains(t, res.Text, "ntp server 10.0.0.1")
+
+	b, err := os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `failures="1"`)
+}
+
+func TestComplianceRunInvalidReportFormat(t *testing.T) {
+	runner := NewComplianceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	_, err := runner.Run(Request{
+		Options: &flags.ComplianceRunOptions{Tree: "ios", ReportFormat: "xml"},
+	})
+
+	assert.Error(t, err)
+}
@@ -5,10 +5,14 @@
 package runners
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/terminal"
 	"github.com/itential/ipctl/pkg/client"
 	"github.com/itential/ipctl/pkg/services"
 )
@@ -25,44 +29,292 @@ func NewConfigurationParserRunner(client client.Client, cfg config.Provider) *Co
 	}
 }
 
-// Get is the implementation of the command `get devices`
+/*
+*******************************************************************************
+Reader interface
+*******************************************************************************
+*/
+
+// Get implements the `get configuration-parsers` command
 func (r *ConfigurationParserRunner) Get(in Request) (*Response, error) {
 	logging.Trace()
 
-	devices, err := r.service.GetAll()
+	parsers, err := r.service.GetAll()
 	if err != nil {
 		return nil, err
 	}
 
-	display := []string{"NAME"}
-	for _, ele := range devices {
-		lines := []string{ele.Name}
-		display = append(display, strings.Join(lines, "\t"))
-	}
-
 	return &Response{
 		Keys:   []string{"name"},
-		Object: devices,
+		Object: parsers,
 	}, nil
 
 }
 
+// Describe implements the `describe configuration-parser <name>` command
 func (r *ConfigurationParserRunner) Describe(in Request) (*Response, error) {
 	logging.Trace()
+
+	parser, err := r.service.Get(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	output := []string{
+		fmt.Sprintf("Name: %s", parser.Name),
+		fmt.Sprintf("Lex Rules: %v", len(parser.LexRules)),
+	}
+
+	if parser.Template != "" {
+		output = append(output, "", parser.Template)
+	}
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: parser,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Writer interface
+*******************************************************************************
+*/
+
+// Create implements the `create configuration-parser <name>` command
+func (r *ConfigurationParserRunner) Create(in Request) (*Response, error) {
+	logging.Trace()
+
+	name := in.Args[0]
+
+	existing, err := r.findParser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, fmt.Errorf("configuration parser `%s` already exists", name)
+	}
+
+	res, err := r.service.Create(services.NewConfigurationParser(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully created configuration parser `%s`", name),
+		Object: res,
+	}, nil
+}
+
+// Delete implements the `delete configuration-parser <name>` command
+func (r *ConfigurationParserRunner) Delete(in Request) (*Response, error) {
+	logging.Trace()
+
+	name := in.Args[0]
+
+	existing, err := r.findParser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("configuration parser `%s` not found", name)
+	}
+
+	if err := r.service.Delete(name); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully deleted configuration parser `%s`", name),
+	}, nil
+}
+
+// Clear is not supported for configuration parsers
+func (r *ConfigurationParserRunner) Clear(in Request) (*Response, error) {
+	logging.Trace()
 	return notImplemented(in)
-	/*
+}
 
-		name := in.Args[0]
+/*
+*******************************************************************************
+Importer interface
+*******************************************************************************
+*/
 
-		res, err := r.service.Get(name)
-		if err != nil {
-			return nil, err
+// Import implements the `import configuration-parser <path>` command
+func (r *ConfigurationParserRunner) Import(in Request) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetImportCommon)
+
+	var parser services.ConfigurationParser
+
+	if err := importUnmarshalFromRequest(in, &parser); err != nil {
+		return nil, err
+	}
+
+	if err := r.importConfigurationParser(parser, common.Replace); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully imported configuration parser `%s`", parser.Name),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Exporter interface
+*******************************************************************************
+*/
+
+// Export implements the `export configuration-parser <name>` command
+func (r *ConfigurationParserRunner) Export(in Request) (*Response, error) {
+	logging.Trace()
+
+	parser, err := r.service.Get(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fn := fmt.Sprintf("%s.configuration_parser.json", parser.Name)
+
+	if err := exportAssetFromRequest(in, "configuration_parser", parser, fn); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully exported configuration parser `%s`", parser.Name),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Dumper interface
+*******************************************************************************
+*/
+
+// Dump implements the `dump configuration-parsers` command
+func (r *ConfigurationParserRunner) Dump(in Request) (*Response, error) {
+	logging.Trace()
+
+	parsers, err := r.service.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var assets = map[string]interface{}{}
+
+	for _, ele := range parsers {
+		key := fmt.Sprintf("%s.configuration_parser.json", ele.Name)
+		assets[key] = ele
+	}
+
+	if err := dumpAssets(in, "configuration_parser", assets); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Dumped %v configuration parser(s)", len(assets)),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Loader interface
+*******************************************************************************
+*/
+
+// Load implements the `load configuration-parsers <path>` command
+func (r *ConfigurationParserRunner) Load(in Request) (*Response, error) {
+	logging.Trace()
+
+	elements, err := loadAssets(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded int
+	var skipped int
+
+	for fn, ele := range elements {
+		var parser services.ConfigurationParser
+
+		if err := loadUnmarshalAsset(ele, &parser); err != nil || parser.Name == "" {
+			terminal.Display("Failed to load configuration parser from `%s`, skipping", fn)
+			skipped++
+			continue
+		}
+
+		if err := r.importConfigurationParser(parser, false); err != nil {
+			if !errors.Is(err, errConfigurationParserExists) {
+				return nil, err
+			}
+			terminal.Display("Skipping `%s`, configuration parser `%s` already exists", fn, parser.Name)
+			skipped++
+		} else {
+			terminal.Display("Loaded configuration parser `%s` successfully from `%s`", parser.Name, fn)
+			loaded++
+		}
+	}
+
+	output := fmt.Sprintf("\nSuccessfully loaded %v and skipped %v files from `%s`", loaded, skipped, in.Args[0])
+
+	return &Response{
+		Text: output,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Private functions
+*******************************************************************************
+*/
+
+var errConfigurationParserExists = errors.New("configuration parser already exists")
+
+// findParser returns the configuration parser with the specified name or nil
+// if the parser does not exist on the server
+func (r *ConfigurationParserRunner) findParser(name string) (*services.ConfigurationParser, error) {
+	logging.Trace()
+
+	parsers, err := r.service.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ele := range parsers {
+		if ele.Name == name {
+			return &ele, nil
 		}
+	}
+
+	return nil, nil
+}
+
+func (r *ConfigurationParserRunner) importConfigurationParser(in services.ConfigurationParser, replace bool) error {
+	logging.Trace()
+
+	if in.Name == "" {
+		return errors.New("configuration parser is missing required field `name`")
+	}
+
+	existing, err := r.findParser(in.Name)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		if !replace {
+			return fmt.Errorf("%w: `%s`", errConfigurationParserExists, in.Name)
+		}
+		if err := r.service.Delete(in.Name); err != nil {
+			return err
+		}
+	}
 
-		return &Response{
-			fmt.Sprintf("Name: %s", res.Name),
-			WithUrl(fmt.Sprintf("/configuration_manager/#/devices/%s", res.Name)),
-			Object: (res),
-		}, nil
-	*/
+	_, err = r.service.Create(in)
+	return err
 }
@@ -0,0 +1,57 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigurationParserImport(t *testing.T) {
+	runner := NewConfigurationParserRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	var methods []string
+
+	testlib.AddHandlerToMux("/configuration_manager/configurations/parser", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"list": [{"id": "1", "name": "cisco-ios"}], "total": 1}`))
+		} else {
+			w.Write([]byte(`{"id": "2", "name": "cisco-ios"}`))
+		}
+	})
+
+	fn := filepath.Join(t.TempDir(), "cisco-ios.configuration_parser.json")
+	require.NoError(t, os.WriteFile(fn, []byte(`{"name": "cisco-ios", "lexRules": [["^hostname", "hostname"]]}`), 0644))
+
+	_, err := runner.Import(Request{
+		Args:   []string{fn},
+		Common: &flags.AssetImportCommon{},
+	})
+	assert.ErrorIs(t, err, errConfigurationParserExists)
+	assert.Equal(t, []string{http.MethodGet}, methods)
+
+	methods = nil
+
+	res, err := runner.Import(Request{
+		Args:   []string{fn},
+		Common: &flags.AssetImportCommon{Replace: true},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "cisco-ios")
+	assert.Equal(t, []string{http.MethodGet, http.MethodDelete, http.MethodPost}, methods)
+}
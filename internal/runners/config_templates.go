@@ -0,0 +1,479 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/terminal"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// ConfigTemplateRunner implements the commands for working with
+// Configuration Manager configuration templates
+type ConfigTemplateRunner struct {
+	BaseRunner
+	resource       resources.ConfigurationTemplateResourcer
+	config_manager *services.ConfigManagerService
+	devices        *services.DeviceService
+}
+
+func NewConfigTemplateRunner(client client.Client, cfg config.Provider) *ConfigTemplateRunner {
+	return &ConfigTemplateRunner{
+		BaseRunner:     NewBaseRunner(client, cfg),
+		resource:       resources.NewConfigurationTemplateResource(services.NewConfigurationTemplateService(client)),
+		config_manager: services.NewConfigManagerService(client),
+		devices:        services.NewDeviceService(client),
+	}
+}
+
+/*
+*******************************************************************************
+Reader interface
+*******************************************************************************
+*/
+
+// Get implements the `get config-templates` command
+func (r *ConfigTemplateRunner) Get(in Request) (*Response, error) {
+	logging.Trace()
+
+	templates, err := r.resource.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Keys:   []string{"name"},
+		Object: templates,
+	}, nil
+}
+
+// Describe implements the `describe config-template <name>` command
+func (r *ConfigTemplateRunner) Describe(in Request) (*Response, error) {
+	logging.Trace()
+
+	res, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	output := []string{
+		fmt.Sprintf("Name: %s (%s)", res.Name, res.Id),
+		fmt.Sprintf("Device OS Types: %s", strings.Join(res.DeviceOsTypes, ", ")),
+		fmt.Sprintf("Created: %s by %s", res.Created, res.CreatedBy),
+		fmt.Sprintf("Updated: %s by %s", res.Updated, res.UpdatedBy),
+	}
+
+	if res.Template != "" {
+		output = append(output, "", res.Template)
+	}
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: res,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Writer interface
+*******************************************************************************
+*/
+
+// Create implements the `create config-template <name>` command
+func (r *ConfigTemplateRunner) Create(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.ConfigTemplateCreateOptions)
+
+	name := in.Args[0]
+
+	template := services.NewConfigurationTemplate(name)
+
+	if options.Template != "" {
+		b, err := readArgData(options.Template)
+		if err != nil {
+			return nil, err
+		}
+		template.Template = string(b)
+	}
+
+	existing, err := r.resource.GetByName(name)
+	if err != nil && !errors.Is(err, resources.ErrNotFound) {
+		return nil, err
+	}
+
+	if existing != nil {
+		if !options.Replace {
+			return nil, fmt.Errorf("config-template `%s` already exists, use `--replace` to overwrite it", name)
+		}
+		if err := r.resource.Delete(existing.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := r.resource.Create(template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully created config-template `%s`", name),
+		Object: res,
+	}, nil
+}
+
+// Delete implements the `delete config-template <name>` command
+func (r *ConfigTemplateRunner) Delete(in Request) (*Response, error) {
+	logging.Trace()
+
+	t, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.resource.Delete(t.Id); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully deleted config-template `%s` (%s)", t.Name, t.Id),
+	}, nil
+}
+
+// Clear is not supported for config templates
+func (r *ConfigTemplateRunner) Clear(in Request) (*Response, error) {
+	logging.Trace()
+	return notImplemented(in)
+}
+
+/*
+*******************************************************************************
+Copier interface
+*******************************************************************************
+*/
+
+// Copy implements the `copy config-template <name>` command
+func (r *ConfigTemplateRunner) Copy(in Request) (*Response, error) {
+	logging.Trace()
+
+	res, err := Copy(CopyRequest{Request: in, Type: "config-template"}, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully copied config-template `%s` from `%s` to `%s`", res.Name, res.From, res.To),
+	}, nil
+}
+
+func (r *ConfigTemplateRunner) CopyFrom(profile, name string) (any, error) {
+	logging.Trace()
+
+	client, cancel, err := NewClient(profile, r.config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	res, err := resources.NewConfigurationTemplateResource(
+		services.NewConfigurationTemplateService(client),
+	).GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return *res, nil
+}
+
+func (r *ConfigTemplateRunner) CopyTo(profile string, in any, replace bool) (any, error) {
+	logging.Trace()
+
+	client, cancel, err := NewClient(profile, r.config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	resource := resources.NewConfigurationTemplateResource(services.NewConfigurationTemplateService(client))
+
+	if err := importConfigTemplate(resource, in.(services.ConfigurationTemplate), replace); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+/*
+*******************************************************************************
+Importer interface
+*******************************************************************************
+*/
+
+// Import implements the `import config-template <path>` command
+func (r *ConfigTemplateRunner) Import(in Request) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetImportCommon)
+
+	var template services.ConfigurationTemplate
+
+	if err := importUnmarshalFromRequest(in, &template); err != nil {
+		return nil, err
+	}
+
+	if err := importConfigTemplate(r.resource, template, common.Replace); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully imported config-template `%s`", template.Name),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Exporter interface
+*******************************************************************************
+*/
+
+// Export implements the `export config-template <name>` command
+func (r *ConfigTemplateRunner) Export(in Request) (*Response, error) {
+	logging.Trace()
+
+	t, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	exported, err := r.resource.Export(t.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	fn := fmt.Sprintf("%s.config_template.json", exported.Name)
+
+	if err := exportAssetFromRequest(in, "config_template", exported, fn); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully exported config-template `%s` (%s)", exported.Name, exported.Id),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Dumper interface
+*******************************************************************************
+*/
+
+// Dump implements the `dump config-templates` command
+func (r *ConfigTemplateRunner) Dump(in Request) (*Response, error) {
+	logging.Trace()
+
+	templates, err := r.resource.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var assets = map[string]interface{}{}
+
+	for _, ele := range templates {
+		key := fmt.Sprintf("%s.config_template.json", ele.Name)
+		assets[key] = ele
+	}
+
+	if err := dumpAssets(in, "config_template", assets); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Dumped %v config-template(s)", len(assets)),
+	}, nil
+}
+
+/*
+*******************************************************************************
+Loader interface
+*******************************************************************************
+*/
+
+// Load implements the `load config-templates <path>` command
+func (r *ConfigTemplateRunner) Load(in Request) (*Response, error) {
+	logging.Trace()
+
+	elements, err := loadAssets(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded int
+	var skipped int
+
+	for fn, ele := range elements {
+		var template services.ConfigurationTemplate
+
+		if err := loadUnmarshalAsset(ele, &template); err != nil || template.Name == "" {
+			terminal.Display("Failed to load config-template from `%s`, skipping", fn)
+			skipped++
+			continue
+		}
+
+		if err := importConfigTemplate(r.resource, template, false); err != nil {
+			if !errors.Is(err, errConfigTemplateExists) {
+				return nil, err
+			}
+			terminal.Display("Skipping `%s`, config-template `%s` already exists", fn, template.Name)
+			skipped++
+		} else {
+			terminal.Display("Loaded config-template `%s` successfully from `%s`", template.Name, fn)
+			loaded++
+		}
+	}
+
+	output := fmt.Sprintf("\nSuccessfully loaded %v and skipped %v files from `%s`", loaded, skipped, in.Args[0])
+
+	return &Response{
+		Text: output,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Renderer interface
+*******************************************************************************
+*/
+
+// Render implements the `render config-template <name|@file>` command
+func (r *ConfigTemplateRunner) Render(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.ConfigTemplateRenderOptions)
+
+	name := in.Args[0]
+
+	var template string
+	var variables = map[string]interface{}{}
+
+	if strings.HasPrefix(name, "@") {
+		b, err := readArgData(name)
+		if err != nil {
+			return nil, err
+		}
+		template = string(b)
+	} else {
+		t, err := r.resource.GetByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		template = t.Template
+
+		for key, value := range t.Variables {
+			variables[key] = value
+		}
+	}
+
+	vars, err := readArgVariables(options.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range vars {
+		variables[key] = value
+	}
+
+	rendered, err := r.config_manager.Render(services.ConfigManagerJinja2Template{
+		Template:  template,
+		Variables: variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]interface{}{
+		"template": name,
+		"rendered": rendered,
+	}
+
+	output := []string{rendered}
+
+	if options.Device != "" {
+		current, err := r.devices.GetConfiguration(options.Device)
+		if err != nil {
+			return nil, err
+		}
+
+		diff, err := utils.UnifiedDiff(current.Config, rendered, options.Device, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result["device"] = options.Device
+		result["diff"] = diff
+
+		if diff == "" {
+			output = append(output, fmt.Sprintf("No differences found between device `%s` and the rendered template", options.Device))
+		} else {
+			output = append(output, diff)
+		}
+	}
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: result,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Private functions
+*******************************************************************************
+*/
+
+var errConfigTemplateExists = errors.New("config-template already exists")
+
+// importConfigTemplate creates the config template using the resource.  If a
+// template with the same name already exists, it is deleted first when
+// replace is true, otherwise an error is returned.
+func importConfigTemplate(resource resources.ConfigurationTemplateResourcer, in services.ConfigurationTemplate, replace bool) error {
+	logging.Trace()
+
+	if in.Name == "" {
+		return errors.New("config-template is missing required field `name`")
+	}
+
+	existing, err := resource.GetByName(in.Name)
+	if err != nil && !errors.Is(err, resources.ErrNotFound) {
+		return err
+	}
+
+	if existing != nil {
+		if !replace {
+			return fmt.Errorf("%w: `%s`, use `--replace` to overwrite", errConfigTemplateExists, in.Name)
+		}
+		logging.Debug("config-template `%s` exists, deleting it", in.Name)
+		if err := resource.Delete(existing.Id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := resource.Import(in); err != nil {
+		return err
+	}
+
+	logging.Debug("successfully imported config-template `%s`", in.Name)
+
+	return nil
+}
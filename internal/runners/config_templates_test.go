@@ -0,0 +1,65 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigTemplateImport(t *testing.T) {
+	runner := NewConfigTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/templates/search",
+		`{"list": [{"id": "abc123", "name": "IOS Base"}], "total": 1}`,
+		200,
+	)
+
+	var deleted bool
+	testlib.AddHandlerToMux("/configuration_manager/templates/abc123", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		deleted = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var created map[string]interface{}
+	testlib.AddHandlerToMux("/configuration_manager/templates", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&created)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"result": "success", "data": {"id": "def456", "name": "IOS Base"}}`))
+	})
+
+	fn := filepath.Join(t.TempDir(), "IOS Base.config_template.json")
+	require.NoError(t, os.WriteFile(fn, []byte(`{"name": "IOS Base", "template": "hostname {{ hostname }}"}`), 0644))
+
+	_, err := runner.Import(Request{
+		Args:   []string{fn},
+		Common: &flags.AssetImportCommon{},
+	})
+	assert.ErrorIs(t, err, errConfigTemplateExists)
+	assert.False(t, deleted)
+	assert.Nil(t, created)
+
+	_, err = runner.Import(Request{
+		Args:   []string{fn},
+		Common: &flags.AssetImportCommon{Replace: true},
+	})
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, "hostname {{ hostname }}", created["template"])
+}
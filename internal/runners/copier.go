@@ -42,6 +42,15 @@ func Copy(in CopyRequest, r Copier) (*CopyResponse, error) {
 		return nil, err
 	}
 
+	// Assets are converted to the format of the destination server when
+	// the servers run different platform releases
+	if in.Request.Config != nil {
+		src, err = newCopyMigration(common.From, common.To, in.Request.Config).apply(in.Type, src)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	res, err := r.CopyTo(common.To, src, common.Replace)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,654 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+const (
+	dependencyWorkflow        = "workflow"
+	dependencyAutomation      = "automation"
+	dependencyTransformation  = "transformation"
+	dependencyJsonForm        = "jsonform"
+	dependencyTemplate        = "template"
+	dependencyCommandTemplate = "command_template"
+	dependencyMethod          = "method"
+
+	dependencyManifestFilename = "manifest.json"
+)
+
+// assetDependency is a single node in the dependency graph of an asset.  The
+// Asset field holds the exported asset document in the same form returned
+// by the CopyFrom function of the runner for the asset kind.
+type assetDependency struct {
+	Kind         string             `json:"kind"`
+	Name         string             `json:"name"`
+	Missing      bool               `json:"missing,omitempty"`
+	Error        string             `json:"error,omitempty"`
+	Dependencies []*assetDependency `json:"dependencies,omitempty"`
+	Asset        any                `json:"-"`
+}
+
+func (d *assetDependency) key() string {
+	return d.Kind + "/" + d.Name
+}
+
+// dependencyReference is a reference from one asset to another.  The value
+// is the name of the asset except for transformations and JSON forms which
+// are referenced by id.
+type dependencyReference struct {
+	Kind  string
+	Value string
+}
+
+// dependencyManifest describes the contents of a bundle written by
+// `export --with-dependencies`.  Assets are listed in the order they must be
+// imported.
+type dependencyManifest struct {
+	Kind    string                    `json:"kind"`
+	Name    string                    `json:"name"`
+	Assets  []dependencyManifestAsset `json:"assets"`
+	Methods []string                  `json:"methods"`
+}
+
+type dependencyManifestAsset struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	File string `json:"file"`
+}
+
+// dependencyResolver walks the references of workflows and automations on a
+// server and builds the dependency graph.
+type dependencyResolver struct {
+	workflows        *services.WorkflowService
+	automations      resources.AutomationResourcer
+	transformations  resources.TransformationResourcer
+	jsonforms        resources.JsonFormResourcer
+	templates        resources.TemplateResourcer
+	commandTemplates *services.CommandTemplateService
+	nodes            map[string]*assetDependency
+}
+
+func newDependencyResolver(c client.Client) *dependencyResolver {
+	return &dependencyResolver{
+		workflows:        services.NewWorkflowService(c),
+		automations:      resources.NewAutomationResource(services.NewAutomationService(c)),
+		transformations:  resources.NewTransformationResource(services.NewTransformationService(c)),
+		jsonforms:        resources.NewJsonFormResource(services.NewJsonFormService(c)),
+		templates:        resources.NewTemplateResource(services.NewTemplateService(c)),
+		commandTemplates: services.NewCommandTemplateService(c),
+		nodes:            map[string]*assetDependency{},
+	}
+}
+
+// Resolve returns the dependency graph for the asset.  Referenced assets
+// that can not be found are included in the graph with the Error field set.
+// An error is only returned if the root asset can not be found.
+func (d *dependencyResolver) Resolve(kind, name string) (*assetDependency, error) {
+	logging.Trace()
+
+	node, err := d.resolve(dependencyReference{Kind: kind, Value: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (d *dependencyResolver) resolve(ref dependencyReference) (*assetDependency, error) {
+	if node, exists := d.nodes[ref.Kind+"#"+ref.Value]; exists {
+		return node, nil
+	}
+
+	node := &assetDependency{Kind: ref.Kind, Name: ref.Value}
+	d.nodes[ref.Kind+"#"+ref.Value] = node
+
+	var refs []dependencyReference
+
+	switch ref.Kind {
+	case dependencyWorkflow:
+		res, err := d.workflows.Export(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		node.Asset = *res
+		refs = workflowReferences(*res)
+
+	case dependencyAutomation:
+		res, err := d.automations.GetByName(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		exported, err := d.automations.Export(res.Id)
+		if err != nil {
+			return nil, err
+		}
+		node.Asset = *exported
+		refs = automationReferences(*exported)
+
+	case dependencyTransformation:
+		res, err := d.transformations.Get(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		node.Name = res.Name
+		node.Asset = *res
+
+	case dependencyJsonForm:
+		res, err := d.jsonforms.Get(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		node.Name = res.Name
+		node.Asset = *res
+
+	case dependencyTemplate:
+		res, err := d.templates.GetByName(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		exported, err := d.templates.Export(res.Id)
+		if err != nil {
+			return nil, err
+		}
+		node.Asset = *exported
+
+	case dependencyCommandTemplate:
+		res, err := d.commandTemplates.Get(ref.Value)
+		if err != nil {
+			return nil, err
+		}
+		node.Asset = *res
+
+	case dependencyMethod:
+		return node, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported dependency kind `%s`", ref.Kind)
+	}
+
+	d.resolveReferences(node, refs)
+
+	return node, nil
+}
+
+// ResolveWorkflow returns the dependency graph for a workflow document that
+// was not loaded from the server, such as a local file.
+func (d *dependencyResolver) ResolveWorkflow(wf services.Workflow) *assetDependency {
+	logging.Trace()
+
+	node := &assetDependency{Kind: dependencyWorkflow, Name: wf.Name, Asset: wf}
+	d.nodes[dependencyWorkflow+"#"+wf.Name] = node
+
+	d.resolveReferences(node, workflowReferences(wf))
+
+	return node
+}
+
+func (d *dependencyResolver) resolveReferences(node *assetDependency, refs []dependencyReference) {
+	for _, ele := range refs {
+		child, err := d.resolve(ele)
+		if err != nil {
+			logging.Debug("failed to resolve %s `%s`: %s", ele.Kind, ele.Value, err)
+			child = &assetDependency{Kind: ele.Kind, Name: ele.Value, Error: err.Error()}
+			d.nodes[ele.Kind+"#"+ele.Value] = child
+		}
+		node.Dependencies = append(node.Dependencies, child)
+	}
+}
+
+// workflowReferences returns the assets referenced by the tasks in a
+// workflow sorted by kind and value.
+func workflowReferences(wf services.Workflow) []dependencyReference {
+	seen := map[dependencyReference]bool{}
+	var refs []dependencyReference
+
+	add := func(kind, value string) {
+		if value == "" || strings.HasPrefix(value, "$var.") {
+			return
+		}
+		ref := dependencyReference{Kind: kind, Value: value}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	for _, key := range workflowTaskKeys(wf) {
+		if key == workflowStartTask || key == workflowEndTask {
+			continue
+		}
+
+		task := workflowTaskMap(wf, key)
+		incoming := workflowTaskIncoming(task)
+
+		app := workflowTaskString(task, "app")
+		method := workflowTaskString(task, "name")
+
+		if method == "childJob" {
+			add(dependencyWorkflow, workflowChildName(task))
+		}
+
+		if incoming != nil {
+			if value, ok := incoming["tr_id"].(string); ok {
+				add(dependencyTransformation, value)
+			}
+			if value, ok := incoming["formId"].(string); ok {
+				add(dependencyJsonForm, value)
+			}
+			if value, ok := incoming["template"].(string); ok {
+				switch app {
+				case "TemplateBuilder":
+					add(dependencyTemplate, value)
+				case "MOP":
+					add(dependencyCommandTemplate, value)
+				}
+			}
+		}
+
+		if app != "" && method != "" && !workflowBuiltinApps[app] {
+			add(dependencyMethod, app+"."+method)
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Kind != refs[j].Kind {
+			return refs[i].Kind < refs[j].Kind
+		}
+		return refs[i].Value < refs[j].Value
+	})
+
+	return refs
+}
+
+// automationReferences returns the workflow and JSON forms referenced by an
+// exported automation.
+func automationReferences(in services.Automation) []dependencyReference {
+	var refs []dependencyReference
+
+	if in.ComponentType == "workflows" && in.ComponentName != "" {
+		refs = append(refs, dependencyReference{Kind: dependencyWorkflow, Value: in.ComponentName})
+	}
+
+	seen := map[string]bool{}
+	for _, ele := range in.Triggers {
+		trigger, _ := ele.(map[string]interface{})
+		if id, ok := trigger["formId"].(string); ok && id != "" && !seen[id] {
+			seen[id] = true
+			refs = append(refs, dependencyReference{Kind: dependencyJsonForm, Value: id})
+		}
+	}
+
+	return refs
+}
+
+// dependencyOrder returns the nodes of the graph in topological order with
+// every dependency listed before the assets that reference it.  Each node is
+// only listed once.
+func dependencyOrder(root *assetDependency) []*assetDependency {
+	var res []*assetDependency
+	visited := map[string]bool{}
+
+	var visit func(*assetDependency)
+	visit = func(node *assetDependency) {
+		if visited[node.key()] {
+			return
+		}
+		visited[node.key()] = true
+		for _, ele := range node.Dependencies {
+			visit(ele)
+		}
+		res = append(res, node)
+	}
+
+	visit(root)
+
+	return res
+}
+
+// dependencyUnresolved returns an error describing all of the dependencies
+// that could not be found on the source server.
+func dependencyUnresolved(root *assetDependency) error {
+	var missing []string
+	for _, ele := range dependencyOrder(root) {
+		if ele.Error != "" {
+			missing = append(missing, fmt.Sprintf("%s `%s`", ele.Kind, ele.Name))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("unable to resolve dependencies: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// markMissingDependencies checks every node in the graph against the server
+// and sets the Missing field on the nodes that do not exist.  It returns the
+// number of missing nodes.
+func markMissingDependencies(root *assetDependency, c client.Client) (int, error) {
+	logging.Trace()
+
+	workflows := services.NewWorkflowService(c)
+	automations := resources.NewAutomationResource(services.NewAutomationService(c))
+	transformations := resources.NewTransformationResource(services.NewTransformationService(c))
+	jsonforms := resources.NewJsonFormResource(services.NewJsonFormService(c))
+	templates := resources.NewTemplateResource(services.NewTemplateService(c))
+	commandTemplates := services.NewCommandTemplateService(c)
+
+	var methods map[string]bool
+
+	var count int
+
+	for _, ele := range dependencyOrder(root) {
+		var err error
+
+		switch ele.Kind {
+		case dependencyWorkflow:
+			_, err = workflows.Get(ele.Name)
+		case dependencyAutomation:
+			_, err = automations.GetByName(ele.Name)
+		case dependencyTransformation:
+			_, err = transformations.GetByName(ele.Name)
+		case dependencyJsonForm:
+			_, err = jsonforms.GetByName(ele.Name)
+		case dependencyTemplate:
+			_, err = templates.GetByName(ele.Name)
+		case dependencyCommandTemplate:
+			_, err = commandTemplates.Get(ele.Name)
+		case dependencyMethod:
+			if methods == nil {
+				res, e := services.NewMethodService(c).GetAll()
+				if e != nil {
+					return 0, e
+				}
+				methods = map[string]bool{}
+				for _, m := range res {
+					methods[m.Provenance+"."+m.Name] = true
+				}
+			}
+			if !methods[ele.Name] {
+				err = fmt.Errorf("method `%s` not found", ele.Name)
+			}
+		}
+
+		ele.Missing = err != nil
+		if ele.Missing {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// dependencyTree renders the dependency graph as a tree.  Nodes that have
+// already been displayed are not expanded a second time.
+func dependencyTree(root *assetDependency) string {
+	var lines []string
+	printed := map[string]bool{}
+
+	label := func(node *assetDependency) string {
+		s := node.key()
+		if node.Error != "" {
+			s += " (not found)"
+		} else if node.Missing {
+			s += " (missing)"
+		}
+		return s
+	}
+
+	var walk func(*assetDependency, string)
+	walk = func(node *assetDependency, prefix string) {
+		for idx, ele := range node.Dependencies {
+			branch, indent := "├── ", "│   "
+			if idx == len(node.Dependencies)-1 {
+				branch, indent = "└── ", "    "
+			}
+
+			if printed[ele.key()] && len(ele.Dependencies) > 0 {
+				lines = append(lines, prefix+branch+label(ele)+" (see above)")
+				continue
+			}
+			printed[ele.key()] = true
+
+			lines = append(lines, prefix+branch+label(ele))
+			walk(ele, prefix+indent)
+		}
+	}
+
+	printed[root.key()] = true
+	lines = append(lines, label(root))
+	walk(root, "")
+
+	return strings.Join(lines, "\n")
+}
+
+// dependencyFilename returns the name of the file used for an asset in a
+// dependency bundle.
+func dependencyFilename(node *assetDependency) string {
+	return fmt.Sprintf("%s.%s.json", normalizeFilename(node.Name), node.Kind)
+}
+
+// exportDependencies writes the asset and all of its dependencies along with
+// a manifest file.
+func exportDependencies(in Request, root *assetDependency) (*dependencyManifest, error) {
+	logging.Trace()
+
+	if err := dependencyUnresolved(root); err != nil {
+		return nil, err
+	}
+
+	manifest := &dependencyManifest{
+		Kind:    root.Kind,
+		Name:    root.Name,
+		Assets:  []dependencyManifestAsset{},
+		Methods: []string{},
+	}
+
+	assets := map[string]interface{}{}
+
+	for _, ele := range dependencyOrder(root) {
+		if ele.Kind == dependencyMethod {
+			manifest.Methods = append(manifest.Methods, ele.Name)
+			continue
+		}
+		fn := dependencyFilename(ele)
+		asset, err := canonicalAssetFromRequest(in, ele.Kind, ele.Asset)
+		if err != nil {
+			return nil, err
+		}
+		assets[fn] = asset
+		manifest.Assets = append(manifest.Assets, dependencyManifestAsset{
+			Kind: ele.Kind,
+			Name: ele.Name,
+			File: fn,
+		})
+	}
+
+	assets[dependencyManifestFilename] = manifest
+
+	if err := exportAssets(in, "", assets); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// copyDependencies copies the asset and its dependencies from one server to
+// another in dependency order.  Dependencies that already exist on the
+// destination server are skipped unless replace is set.  The copy fails
+// before any asset is written if a method used by the graph is missing on
+// the destination server.
+func copyDependencies(in Request, kind string, cfg config.Provider) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetCopyCommon)
+
+	if common.From == common.To {
+		return nil, fmt.Errorf("source (--from) and destination (--to) servers must be different values")
+	}
+
+	src, cancel, err := NewClient(common.From, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	root, err := newDependencyResolver(src).Resolve(kind, in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dependencyUnresolved(root); err != nil {
+		return nil, err
+	}
+
+	dst, dstCancel, err := NewClient(common.To, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer dstCancel()
+
+	if _, err := markMissingDependencies(root, dst); err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	for _, ele := range dependencyOrder(root) {
+		if ele.Kind == dependencyMethod && ele.Missing {
+			methods = append(methods, ele.Name)
+		}
+	}
+	if len(methods) > 0 {
+		return nil, fmt.Errorf("methods not available on `%s`: %s", common.To, strings.Join(methods, ", "))
+	}
+
+	// The automation runner validates the import using its own client so it
+	// is created with the destination client.
+	copiers := map[string]Copier{
+		dependencyWorkflow:        NewWorkflowRunner(src, cfg),
+		dependencyAutomation:      NewAutomationRunner(dst, cfg),
+		dependencyTransformation:  NewTransformationRunner(src, cfg),
+		dependencyJsonForm:        NewJsonFormRunner(src, cfg),
+		dependencyTemplate:        NewTemplateRunner(src, cfg),
+		dependencyCommandTemplate: NewCommandTemplateRunner(src, cfg),
+	}
+
+	migration := newCopyMigration(common.From, common.To, cfg)
+
+	var output []string
+	var copied int
+
+	for _, ele := range dependencyOrder(root) {
+		if ele.Kind == dependencyMethod {
+			continue
+		}
+
+		if ele != root && !ele.Missing && !common.Replace {
+			output = append(output, fmt.Sprintf("Skipping %s `%s`, already exists on `%s`", ele.Kind, ele.Name, common.To))
+			continue
+		}
+
+		asset, err := migration.apply(ele.Kind, ele.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := copiers[ele.Kind].CopyTo(common.To, asset, common.Replace); err != nil {
+			return nil, err
+		}
+
+		output = append(output, fmt.Sprintf("Copied %s `%s`", ele.Kind, ele.Name))
+		copied++
+	}
+
+	output = append(output, fmt.Sprintf(
+		"\nSuccessfully copied %s `%s` and %v asset(s) from `%s` to `%s`",
+		kind, root.Name, copied-1, common.From, common.To,
+	))
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: root,
+	}, nil
+}
+
+/*
+******************************************************************************
+Dependency runner
+******************************************************************************
+*/
+
+// DependencyRunner implements the `get dependencies` command.
+type DependencyRunner struct {
+	BaseRunner
+}
+
+func NewDependencyRunner(c client.Client, cfg config.Provider) *DependencyRunner {
+	return &DependencyRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+	}
+}
+
+// Get implements the `get dependencies <workflow|automation> <name>` command
+func (r *DependencyRunner) Get(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.DependencyGetOptions)
+
+	kind, name := in.Args[0], in.Args[1]
+
+	if kind != dependencyWorkflow && kind != dependencyAutomation {
+		return nil, fmt.Errorf("unsupported asset type `%s`, must be one of `workflow` or `automation`", kind)
+	}
+
+	root, err := newDependencyResolver(r.client).Resolve(kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.To == "" {
+		return &Response{
+			Text:   dependencyTree(root),
+			Object: root,
+		}, nil
+	}
+
+	c, cancel, err := NewClient(options.To, r.config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	missing, err := markMissingDependencies(root, c)
+	if err != nil {
+		return nil, err
+	}
+
+	text := dependencyTree(root)
+	if missing == 0 {
+		text += fmt.Sprintf("\n\nAll dependencies exist on `%s`", options.To)
+	} else {
+		text += fmt.Sprintf("\n\n%v asset(s) missing on `%s`", missing, options.To)
+	}
+
+	return &Response{
+		Text:   text,
+		Object: root,
+	}, nil
+}
+
+// Describe is not supported for dependencies
+func (r *DependencyRunner) Describe(in Request) (*Response, error) {
+	return notImplemented(in)
+}
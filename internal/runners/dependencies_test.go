@@ -0,0 +1,293 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dependencyTestWorkflows() map[string]services.Workflow {
+	parent := services.NewWorkflow("parent")
+	parent.Tasks["c1"] = map[string]interface{}{
+		"name": "childJob",
+		"app":  "WorkFlowEngine",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"workflow": "child"},
+		},
+	}
+	parent.Tasks["t1"] = map[string]interface{}{
+		"name": "transformation",
+		"app":  "WorkFlowEngine",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"tr_id": "jst1"},
+		},
+	}
+	parent.Tasks["m1"] = map[string]interface{}{
+		"name": "RunCommandTemplate",
+		"app":  "MOP",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"template": "ct1"},
+		},
+	}
+	parent.Tasks["a1"] = map[string]interface{}{
+		"name": "doThing",
+		"app":  "AppA",
+	}
+
+	child := services.NewWorkflow("child")
+	child.Tasks["t1"] = map[string]interface{}{
+		"name": "transformation",
+		"app":  "WorkFlowEngine",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"tr_id": "jst1"},
+		},
+	}
+	child.Tasks["c1"] = map[string]interface{}{
+		"name": "childJob",
+		"app":  "WorkFlowEngine",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"workflow": "parent"},
+		},
+	}
+
+	return map[string]services.Workflow{"parent": parent, "child": child}
+}
+
+func setupDependencyMux(t *testing.T) {
+	workflows := dependencyTestWorkflows()
+
+	testlib.AddHandlerToMux("/workflow_builder/export", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		var body struct {
+			Options struct {
+				Name string `json:"name"`
+			} `json:"options"`
+		}
+		require.NoError(t, json.Unmarshal(b, &body))
+
+		wf, exists := workflows[body.Options.Name]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(wf)
+	})
+
+	testlib.AddGetResponseToMux("/transformations/jst1", `{"_id": "jst1", "name": "My JST"}`, 0)
+	testlib.AddGetResponseToMux("/mop/listATemplate/ct1", `[{"name": "ct1"}]`, 0)
+}
+
+func TestWorkflowReferences(t *testing.T) {
+	refs := workflowReferences(dependencyTestWorkflows()["parent"])
+
+	assert.Equal(t, []dependencyReference{
+		{Kind: dependencyCommandTemplate, Value: "ct1"},
+		{Kind: dependencyMethod, Value: "AppA.doThing"},
+		{Kind: dependencyMethod, Value: "MOP.RunCommandTemplate"},
+		{Kind: dependencyTransformation, Value: "jst1"},
+		{Kind: dependencyWorkflow, Value: "child"},
+	}, refs)
+}
+
+func TestDependencyGet(t *testing.T) {
+	runner := NewDependencyRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	setupDependencyMux(t)
+
+	res, err := runner.Get(Request{
+		Args:    []string{"workflow", "parent"},
+		Options: &flags.DependencyGetOptions{},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	assert.Equal(t, `workflow/parent
+├── command_template/ct1
+├── method/AppA.doThing
+├── method/MOP.RunCommandTemplate
+├── transformation/My JST
+└── workflow/child
+    ├── transformation/My JST
+    └── workflow/parent (see above)`, res.Text)
+
+	order := []string{}
+	for _, ele := range dependencyOrder(res.Object.(*assetDependency)) {
+		order = append(order, ele.key())
+	}
+	assert.Equal(t, []string{
+		"command_template/ct1",
+		"method/AppA.doThing",
+		"method/MOP.RunCommandTemplate",
+		"transformation/My JST",
+		"workflow/child",
+		"workflow/parent",
+	}, order)
+}
+
+func TestDependencyGetNotFound(t *testing.T) {
+	runner := NewDependencyRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	setupDependencyMux(t)
+
+	_, err := runner.Get(Request{
+		Args:    []string{"workflow", "missing"},
+		Options: &flags.DependencyGetOptions{},
+	})
+	assert.Error(t, err)
+
+	_, err = runner.Get(Request{
+		Args:    []string{"transformation", "parent"},
+		Options: &flags.DependencyGetOptions{},
+	})
+	assert.Error(t, err)
+}
+
+func TestWorkflowExportWithDependencies(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	setupDependencyMux(t)
+
+	path := t.TempDir()
+
+	res, err := runner.Export(Request{
+		Args:    []string{"parent"},
+		Options: &flags.WorkflowExportOptions{Format: "json", WithDependencies: true},
+		Common:  &flags.AssetExportCommon{Path: path},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	b, err := os.ReadFile(filepath.Join(path, dependencyManifestFilename))
+	require.NoError(t, err)
+
+	var manifest dependencyManifest
+	require.NoError(t, json.Unmarshal(b, &manifest))
+
+	assert.Equal(t, "parent", manifest.Name)
+	assert.Equal(t, []string{"AppA.doThing", "MOP.RunCommandTemplate"}, manifest.Methods)
+
+	var files []string
+	for _, ele := range manifest.Assets {
+		files = append(files, ele.File)
+		assert.FileExists(t, filepath.Join(path, ele.File))
+	}
+	assert.Equal(t, []string{
+		"ct1.command_template.json",
+		"My JST.transformation.json",
+		"child.workflow.json",
+		"parent.workflow.json",
+	}, files)
+}
+
+func TestAutomationExportWithDependencies(t *testing.T) {
+	runner := NewAutomationRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	setupDependencyMux(t)
+
+	testlib.AddGetResponseToMux("/operations-manager/automations", `{
+		"data": [{"_id": "a1", "name": "nightly", "componentType": "workflows", "componentName": "parent"}],
+		"metadata": {"total": 1}
+	}`, 0)
+	testlib.AddGetResponseToMux("/operations-manager/automations/a1/export", `{
+		"data": {"_id": "a1", "name": "nightly", "componentType": "workflows", "componentName": "parent", "triggers": []}
+	}`, 0)
+
+	path := t.TempDir()
+
+	res, err := runner.Export(Request{
+		Args:    []string{"nightly"},
+		Options: &flags.AutomationExportOptions{WithDependencies: true},
+		Common:  &flags.AssetExportCommon{Path: path},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "Successfully exported automation `nightly` with 4 dependent asset(s)", res.Text)
+
+	b, err := os.ReadFile(filepath.Join(path, dependencyManifestFilename))
+	require.NoError(t, err)
+
+	var manifest dependencyManifest
+	require.NoError(t, json.Unmarshal(b, &manifest))
+
+	assert.Equal(t, "automation", manifest.Kind)
+	assert.Equal(t, "nightly", manifest.Name)
+
+	var files []string
+	for _, ele := range manifest.Assets {
+		files = append(files, ele.File)
+		assert.FileExists(t, filepath.Join(path, ele.File))
+	}
+	assert.Equal(t, []string{
+		"ct1.command_template.json",
+		"My JST.transformation.json",
+		"child.workflow.json",
+		"parent.workflow.json",
+		"nightly.automation.json",
+	}, files)
+}
+
+func TestMarkMissingDependencies(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/automation-studio/workflows",
+		`{"items": [{"name": "parent"}], "total": 1}`,
+		0,
+	)
+	testlib.AddGetResponseToMux(
+		"/authorization/methods",
+		`{"results": [{"provenance": "AppA", "name": "doThing"}], "total": 1}`,
+		0,
+	)
+
+	root := &assetDependency{
+		Kind: dependencyWorkflow,
+		Name: "parent",
+		Dependencies: []*assetDependency{
+			{Kind: dependencyMethod, Name: "AppA.doThing"},
+			{Kind: dependencyMethod, Name: "AppB.missing"},
+		},
+	}
+
+	count, err := markMissingDependencies(root, client)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, count)
+	assert.False(t, root.Missing)
+	assert.False(t, root.Dependencies[0].Missing)
+	assert.True(t, root.Dependencies[1].Missing)
+	assert.Contains(t, dependencyTree(root), "method/AppB.missing (missing)")
+}
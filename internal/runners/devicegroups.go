@@ -5,25 +5,35 @@
 package runners
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/itential/ipctl/internal/config"
 	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
 	"github.com/itential/ipctl/pkg/services"
 )
 
 type DeviceGroupRunner struct {
 	BaseRunner
-	service *services.DeviceGroupService
+	service  *services.DeviceGroupService
+	resource resources.DeviceGroupResourcer
+	devices  *services.DeviceService
 }
 
 func NewDeviceGroupRunner(client client.Client, cfg config.Provider) *DeviceGroupRunner {
+	svc := services.NewDeviceGroupService(client)
 	return &DeviceGroupRunner{
 		BaseRunner: NewBaseRunner(client, cfg),
-		service:    services.NewDeviceGroupService(client),
+		service:    svc,
+		resource:   resources.NewDeviceGroupResource(svc),
+		devices:    services.NewDeviceService(client),
 	}
 }
 
@@ -137,3 +147,363 @@ func (r *DeviceGroupRunner) Clear(in Request) (*Response, error) {
 		Text: fmt.Sprintf("Deleted %v device-groups", len(groups)),
 	}, nil
 }
+
+/*
+*******************************************************************************
+Adder interface
+*******************************************************************************
+*/
+
+// Add implements the `add device-group-member <group> <device>...` command
+func (r *DeviceGroupRunner) Add(in Request) (*Response, error) {
+	logging.Trace()
+
+	if len(in.Args) < 2 {
+		return nil, errors.New("requires a device group and at least one device")
+	}
+
+	group, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := r.devices.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var known []string
+	for _, ele := range devices {
+		known = append(known, ele.Name)
+	}
+
+	var add []string
+	var unknown []string
+
+	for _, ele := range in.Args[1:] {
+		if !slices.Contains(known, ele) {
+			unknown = append(unknown, ele)
+		} else if !slices.Contains(group.Devices, ele) && !slices.Contains(add, ele) {
+			add = append(add, ele)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown device(s): %s", strings.Join(unknown, ", "))
+	}
+
+	if len(add) > 0 {
+		if err := r.service.AddDevices(group.Id, add); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Added %v device(s) to device-group `%s`", len(add), group.Name),
+		Object: add,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Remover interface
+*******************************************************************************
+*/
+
+// Remove implements the `remove device-group-member <group> <device>...`
+// command
+func (r *DeviceGroupRunner) Remove(in Request) (*Response, error) {
+	logging.Trace()
+
+	if len(in.Args) < 2 {
+		return nil, errors.New("requires a device group and at least one device")
+	}
+
+	group, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var remove []string
+	var missing []string
+
+	for _, ele := range in.Args[1:] {
+		if !slices.Contains(group.Devices, ele) {
+			missing = append(missing, ele)
+		} else if !slices.Contains(remove, ele) {
+			remove = append(remove, ele)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf(
+			"device(s) not a member of device-group `%s`: %s",
+			group.Name, strings.Join(missing, ", "),
+		)
+	}
+
+	if err := r.service.RemoveDevices(group.Id, remove); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Removed %v device(s) from device-group `%s`", len(remove), group.Name),
+		Object: remove,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Importer interface
+*******************************************************************************
+*/
+
+// Import implements the `import device-group <path>` command
+func (r *DeviceGroupRunner) Import(in Request) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetImportCommon)
+
+	var group services.DeviceGroup
+
+	if err := importUnmarshalFromRequest(in, &group); err != nil {
+		return nil, err
+	}
+
+	if group.Name == "" {
+		return nil, errors.New("device-group is missing required field `name`")
+	}
+
+	existing, err := r.resource.GetByName(group.Name)
+	if err == nil {
+		if !common.Replace {
+			return nil, fmt.Errorf("device-group `%s` already exists, use `--replace` to overwrite it", group.Name)
+		}
+		if err := r.service.Delete(existing.Id); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, resources.ErrNotFound) {
+		return nil, err
+	}
+
+	res, err := r.service.Create(services.NewDeviceGroup(group.Name, group.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(group.Devices) > 0 {
+		if err := r.service.AddDevices(res.Id, group.Devices); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully imported device-group `%s` with %v device(s)", group.Name, len(group.Devices)),
+		Object: res,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Exporter interface
+*******************************************************************************
+*/
+
+// Export implements the `export device-group <name>` command
+func (r *DeviceGroupRunner) Export(in Request) (*Response, error) {
+	logging.Trace()
+
+	group, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fn := fmt.Sprintf("%s.devicegroup.json", group.Name)
+
+	if err := exportAssetFromRequest(in, "devicegroup", group, fn); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully exported device-group `%s`", group.Name),
+		Object: group,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Syncer interface
+*******************************************************************************
+*/
+
+// Sync implements the `sync device-group <name> --match ...` command.  It
+// computes the set of devices that match all of the match expressions and
+// updates the device group so its members are exactly that set.
+func (r *DeviceGroupRunner) Sync(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.DeviceGroupSyncOptions)
+
+	var matchers []deviceMatcher
+	for _, ele := range options.Match {
+		m, err := newDeviceMatcher(ele)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	group, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := r.devices.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var want []string
+	for _, ele := range devices {
+		if matchAllDevices(matchers, ele) {
+			want = append(want, ele.Name)
+		}
+	}
+
+	var add, remove []string
+
+	for _, ele := range want {
+		if !slices.Contains(group.Devices, ele) {
+			add = append(add, ele)
+		}
+	}
+
+	for _, ele := range group.Devices {
+		if !slices.Contains(want, ele) {
+			remove = append(remove, ele)
+		}
+	}
+
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	if !options.DryRun {
+		if len(add) > 0 {
+			if err := r.service.AddDevices(group.Id, add); err != nil {
+				return nil, err
+			}
+		}
+		if len(remove) > 0 {
+			if err := r.service.RemoveDevices(group.Id, remove); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var output []string
+	for _, ele := range add {
+		output = append(output, fmt.Sprintf("+ %s", ele))
+	}
+	for _, ele := range remove {
+		output = append(output, fmt.Sprintf("- %s", ele))
+	}
+
+	summary := fmt.Sprintf(
+		"Device-group `%s` synced: %v matching device(s), %v added, %v removed",
+		group.Name, len(want), len(add), len(remove),
+	)
+	if options.DryRun {
+		summary += " (dry run)"
+	}
+	output = append(output, summary)
+
+	return &Response{
+		Text: strings.Join(output, "\n"),
+		Object: map[string]interface{}{
+			"name":    group.Name,
+			"devices": want,
+			"added":   add,
+			"removed": remove,
+		},
+	}, nil
+}
+
+/*
+*******************************************************************************
+Private functions
+*******************************************************************************
+*/
+
+// deviceMatchExpr parses match expressions in the form `<field><op><value>`
+var deviceMatchExpr = regexp.MustCompile(`^\s*([A-Za-z0-9_.-]+)\s*(=~|!~|!=|=)\s*(.*)$`)
+
+// deviceMatcher matches a single device field against a value.  The
+// supported operators are `=` and `!=` for exact comparisons and `=~` and
+// `!~` for regular expressions.
+type deviceMatcher struct {
+	field string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func newDeviceMatcher(expr string) (deviceMatcher, error) {
+	parts := deviceMatchExpr.FindStringSubmatch(expr)
+	if parts == nil {
+		return deviceMatcher{}, fmt.Errorf("invalid match expression `%s`", expr)
+	}
+
+	m := deviceMatcher{field: parts[1], op: parts[2], value: parts[3]}
+
+	if m.op == "=~" || m.op == "!~" {
+		re, err := regexp.Compile(m.value)
+		if err != nil {
+			return deviceMatcher{}, fmt.Errorf("invalid regular expression in `%s`: %w", expr, err)
+		}
+		m.re = re
+	}
+
+	return m, nil
+}
+
+func (m deviceMatcher) match(d services.Device) bool {
+	var value string
+
+	switch m.field {
+	case "name":
+		value = d.Name
+	case "host":
+		value = d.Host
+	case "ostype":
+		value = d.OsType
+	case "device-type":
+		value = d.DeviceType
+	default:
+		if v, exists := d.Properties[m.field]; exists && v != nil {
+			value = fmt.Sprint(v)
+		}
+	}
+
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.re.MatchString(value)
+	case "!~":
+		return !m.re.MatchString(value)
+	}
+
+	return false
+}
+
+// matchAllDevices returns true if the device matches every matcher
+func matchAllDevices(matchers []deviceMatcher, d services.Device) bool {
+	for _, ele := range matchers {
+		if !ele.match(d) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeviceMatcher(t *testing.T) {
+	device := services.Device{
+		Name:       "nyc-rtr-01",
+		Host:       "10.0.0.1",
+		OsType:     "cisco-ios",
+		Properties: map[string]interface{}{"site": "nyc"},
+	}
+
+	tests := []struct {
+		expr  string
+		match bool
+	}{
+		{"name=~^nyc-", true},
+		{"name!~^nyc-", false},
+		{"name = nyc-rtr-01", true},
+		{"ostype!=cisco-ios", false},
+		{"host=~^10\\.", true},
+		{"site=nyc", true},
+		{"site=sfo", false},
+		{"missing=", true},
+	}
+
+	for _, tt := range tests {
+		m, err := newDeviceMatcher(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.match, m.match(device), tt.expr)
+	}
+
+	_, err := newDeviceMatcher("name")
+	assert.Error(t, err)
+
+	_, err = newDeviceMatcher("name=~[")
+	assert.Error(t, err)
+}
+
+func TestDeviceGroupSync(t *testing.T) {
+	runner := NewDeviceGroupRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/deviceGroups",
+		`[{"id": "abc123", "name": "nyc", "devices": ["nyc-rtr-01", "sfo-rtr-01"]}]`,
+		0,
+	)
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/devices",
+		`{"total": 3, "list": [{"name": "nyc-rtr-01"}, {"name": "nyc-rtr-02"}, {"name": "sfo-rtr-01"}]}`,
+		200,
+	)
+
+	calls := map[string][]string{}
+
+	testlib.AddHandlerToMux("/configuration_manager/devicegroups/abc123/devices", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string][]string
+		json.NewDecoder(r.Body).Decode(&body)
+		calls[r.Method] = body["devices"]
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	res, err := runner.Sync(Request{
+		Args:    []string{"nyc"},
+		Options: &flags.DeviceGroupSyncOptions{Match: []string{"name=~^nyc-"}},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "1 added, 1 removed")
+	assert.Equal(t, []string{"nyc-rtr-02"}, calls[http.MethodPost])
+	assert.Equal(t, []string{"sfo-rtr-01"}, calls[http.MethodDelete])
+
+	calls = map[string][]string{}
+
+	res, err = runner.Sync(Request{
+		Args:    []string{"nyc"},
+		Options: &flags.DeviceGroupSyncOptions{Match: []string{"name=~^nyc-"}, DryRun: true},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "(dry run)")
+	assert.Empty(t, calls)
+}
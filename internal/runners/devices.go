@@ -5,24 +5,54 @@
 package runners
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
 	"github.com/itential/ipctl/pkg/services"
 )
 
+const defaultDeviceBackupMessage = "Backup device configurations"
+
 type DeviceRunner struct {
 	BaseRunner
 	service *services.DeviceService
+	groups  resources.DeviceGroupResourcer
+}
+
+// deviceBackupResult records the outcome of backing up a single device
+type deviceBackupResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	File   string `json:"file"`
+}
+
+// deviceImportResult records the outcome of importing a single inventory row
+type deviceImportResult struct {
+	Row     string `json:"row"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
 }
 
 func NewDeviceRunner(client client.Client, cfg config.Provider) *DeviceRunner {
 	return &DeviceRunner{
 		BaseRunner: NewBaseRunner(client, cfg),
 		service:    services.NewDeviceService(client),
+		groups:     resources.NewDeviceGroupResource(services.NewDeviceGroupService(client)),
 	}
 }
 
@@ -63,3 +93,597 @@ func (r *DeviceRunner) Describe(in Request) (*Response, error) {
 		Object: res,
 	}, nil
 }
+
+/*
+*******************************************************************************
+Writer interface
+*******************************************************************************
+*/
+
+// Create implements the `create device <name> ...` command
+func (r *DeviceRunner) Create(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.DeviceCreateOptions)
+
+	row := deviceImportRow{
+		Name:        in.Args[0],
+		Host:        options.Host,
+		OsType:      options.OsType,
+		Adapter:     options.Adapter,
+		Credentials: options.Credentials,
+	}
+
+	if err := row.validate(); err != nil {
+		return nil, err
+	}
+
+	res, err := r.service.Create(row.Adapter, row.device())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully created device `%s` using adapter `%s`", res.Name, row.Adapter),
+		Object: res,
+	}, nil
+}
+
+// Delete implements the `delete device <name>` command
+func (r *DeviceRunner) Delete(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.DeviceDeleteOptions)
+
+	name := in.Args[0]
+
+	device, err := r.service.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter := options.Adapter
+	if adapter == "" {
+		adapter = deviceAdapter(device)
+		if adapter == "" {
+			return nil, fmt.Errorf("unable to determine the adapter for device `%s`, use `--adapter` to specify it", name)
+		}
+	}
+
+	if err := r.service.Delete(adapter, name); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully deleted device `%s`", name),
+		Object: device,
+	}, nil
+}
+
+// Clear is not supported for devices
+func (r *DeviceRunner) Clear(in Request) (*Response, error) {
+	return notImplemented(in)
+}
+
+/*
+*******************************************************************************
+Importer interface
+*******************************************************************************
+*/
+
+// Import implements the `import devices <path>` command.  The inventory file
+// can be either a CSV file with a header row or a JSON or YAML file that
+// contains a list of devices.  Every row is validated before any devices are
+// created and nothing is imported when one or more rows are invalid.  The
+// response includes the result for each row and an error is returned along
+// with it when one or more rows fail.
+func (r *DeviceRunner) Import(in Request) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetImportCommon)
+	options := in.Options.(*flags.DeviceImportOptions)
+
+	path, err := importGetPathFromRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if common.Repository != "" {
+		defer os.RemoveAll(path)
+	}
+
+	rows, err := readDeviceInventory(path)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := r.service.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]*services.Device{}
+	for i := range devices {
+		existing[devices[i].Name] = &devices[i]
+	}
+
+	results := make([]deviceImportResult, len(rows))
+	statuses := make([]string, len(rows))
+
+	var failed int
+
+	seen := map[string]bool{}
+
+	for idx, row := range rows {
+		results[idx] = deviceImportResult{
+			Row:  strconv.Itoa(row.Row),
+			Name: row.Name,
+		}
+
+		status, err := checkDeviceImport(row, existing[row.Name], seen, common.Replace)
+		if err != nil {
+			results[idx].Status = "failed"
+			results[idx].Message = err.Error()
+			failed++
+		} else {
+			statuses[idx] = status
+		}
+
+		seen[row.Name] = true
+	}
+
+	resp := &Response{
+		Keys:   []string{"row", "name", "status", "message"},
+		Object: results,
+	}
+
+	if failed > 0 || options.DryRun {
+		for idx := range results {
+			if results[idx].Status == "failed" {
+				continue
+			}
+			if options.DryRun {
+				results[idx].Status = fmt.Sprintf("%s (dry run)", statuses[idx])
+			} else {
+				results[idx].Status = "skipped"
+				results[idx].Message = "not imported because other rows failed validation"
+			}
+		}
+
+		if failed > 0 {
+			return resp, fmt.Errorf("%v of %v device(s) failed validation, no devices were imported", failed, len(rows))
+		}
+
+		return resp, nil
+	}
+
+	for idx, row := range rows {
+		if err := r.importDevice(row, existing[row.Name]); err != nil {
+			results[idx].Status = "failed"
+			results[idx].Message = err.Error()
+			failed++
+		} else {
+			results[idx].Status = statuses[idx]
+		}
+	}
+
+	if failed > 0 {
+		return resp, fmt.Errorf("%v of %v device(s) failed to import", failed, len(rows))
+	}
+
+	return resp, nil
+}
+
+// checkDeviceImport validates row and returns the status the row will have
+// once it is imported.  When the device already exists it is only replaced
+// if replace is true.
+func checkDeviceImport(row deviceImportRow, current *services.Device, seen map[string]bool, replace bool) (string, error) {
+	if err := row.validate(); err != nil {
+		return "", err
+	}
+
+	if seen[row.Name] {
+		return "", fmt.Errorf("duplicate device name `%s`", row.Name)
+	}
+
+	if current == nil {
+		return "created", nil
+	}
+
+	if !replace {
+		return "", fmt.Errorf("device `%s` already exists, use `--replace` to overwrite it", row.Name)
+	}
+
+	return "replaced", nil
+}
+
+// importDevice creates the device described by row.  When current is not nil
+// the existing device is deleted first and is put back if the new device
+// can not be created.
+func (r *DeviceRunner) importDevice(row deviceImportRow, current *services.Device) error {
+	logging.Trace()
+
+	var adapter string
+
+	if current != nil {
+		adapter = deviceAdapter(current)
+		if adapter == "" {
+			adapter = row.Adapter
+		}
+		if err := r.service.Delete(adapter, row.Name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.service.Create(row.Adapter, row.device()); err != nil {
+		if current == nil {
+			return err
+		}
+		if _, restoreErr := r.service.Create(adapter, *current); restoreErr != nil {
+			return fmt.Errorf("%s, the existing device could not be restored: %s", err, restoreErr)
+		}
+		return fmt.Errorf("%s, the existing device was restored", err)
+	}
+
+	return nil
+}
+
+/*
+*******************************************************************************
+Backuper interface
+*******************************************************************************
+*/
+
+// Backup implements the `backup devices ...` command.  It fetches the current
+// configuration for each device and writes it to a file named after the
+// device.  When a repository is specified, only the devices whose
+// configuration changed are committed.
+func (r *DeviceRunner) Backup(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.DeviceBackupOptions)
+
+	names, err := r.backupDeviceNames(options.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return &Response{Text: "No devices found to back up"}, nil
+	}
+
+	repo, repoPath, path, err := exportTargetFromRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo != nil {
+		defer os.RemoveAll(repoPath)
+	}
+
+	var results []deviceBackupResult
+	var changed []string
+
+	for _, name := range names {
+		res, err := r.service.GetConfiguration(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get configuration for device `%s`: %w", name, err)
+		}
+
+		fn := fmt.Sprintf("%s.cfg", name)
+
+		dst, err := utils.NormalizeFilename(fn, path)
+		if err != nil {
+			return nil, err
+		}
+
+		status, err := writeDeviceBackup([]byte(res.Config), dst)
+		if err != nil {
+			return nil, err
+		}
+
+		if status != "unchanged" {
+			changed = append(changed, name)
+		}
+
+		results = append(results, deviceBackupResult{
+			Name:   name,
+			Status: status,
+			File:   fn,
+		})
+	}
+
+	if repo != nil && len(changed) > 0 {
+		msg := in.Common.(flags.Committer).GetMessage()
+		if msg == "" {
+			msg = fmt.Sprintf(
+				"%s\n\nChanged devices:\n  - %s\n",
+				defaultDeviceBackupMessage,
+				strings.Join(changed, "\n  - "),
+			)
+		}
+		if err := repo.CommitAndPush(repoPath, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	var output []string
+	for _, ele := range results {
+		output = append(output, fmt.Sprintf("%s: %s", ele.Name, ele.Status))
+	}
+	output = append(output, "", fmt.Sprintf(
+		"Backed up %v device(s), %v changed",
+		len(results), len(changed),
+	))
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: results,
+	}, nil
+}
+
+// backupDeviceNames returns the sorted list of device names to back up.  When
+// group is set, only the members of the device group are returned.
+func (r *DeviceRunner) backupDeviceNames(group string) ([]string, error) {
+	logging.Trace()
+
+	var names []string
+
+	if group != "" {
+		res, err := r.groups.GetByName(group)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, res.Devices...)
+	} else {
+		devices, err := r.service.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, ele := range devices {
+			names = append(names, ele.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// writeDeviceBackup writes the device configuration to dst and returns the
+// status of the backup.  The status is one of `created`, `changed` or
+// `unchanged`.  The file is not rewritten when the configuration is unchanged.
+func writeDeviceBackup(b []byte, dst string) (string, error) {
+	status := "created"
+
+	if utils.PathExists(dst) {
+		current, err := os.ReadFile(dst)
+		if err != nil {
+			return "", err
+		}
+		if bytes.Equal(current, b) {
+			return "unchanged", nil
+		}
+		status = "changed"
+	}
+
+	if err := utils.WriteBytesToDisk(b, dst, true); err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// deviceInventoryColumns is the list of columns supported in a device
+// inventory file
+var deviceInventoryColumns = []string{"name", "host", "ostype", "adapter", "credentials"}
+
+// deviceInventoryRequired is the list of columns that must have a value for
+// every device in the inventory file
+var deviceInventoryRequired = []string{"name", "host", "ostype", "adapter"}
+
+// deviceImportRow is a single device read from an inventory file.  Row is the
+// line number for CSV files and the list position for JSON and YAML files.
+type deviceImportRow struct {
+	Row         int
+	Name        string
+	Host        string
+	OsType      string
+	Adapter     string
+	Credentials string
+}
+
+func (d deviceImportRow) values() map[string]string {
+	return map[string]string{
+		"name":        d.Name,
+		"host":        d.Host,
+		"ostype":      d.OsType,
+		"adapter":     d.Adapter,
+		"credentials": d.Credentials,
+	}
+}
+
+// validate checks that all required values are set
+func (d deviceImportRow) validate() error {
+	values := d.values()
+
+	var missing []string
+	for _, ele := range deviceInventoryRequired {
+		if strings.TrimSpace(values[ele]) == "" {
+			missing = append(missing, ele)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required value(s): %s", strings.Join(missing, ", "))
+	}
+
+	if strings.ContainsAny(d.Host, " \t") {
+		return fmt.Errorf("invalid host `%s`", d.Host)
+	}
+
+	return nil
+}
+
+// device returns the services.Device that is sent to the server
+func (d deviceImportRow) device() services.Device {
+	device := services.Device{
+		Name:   d.Name,
+		Host:   d.Host,
+		OsType: d.OsType,
+	}
+
+	if d.Credentials != "" {
+		device.Properties = map[string]interface{}{"credentials": d.Credentials}
+	}
+
+	return device
+}
+
+// newDeviceImportRow creates a row from a map of column names to values.  An
+// error is returned if the map includes an unsupported column.
+func newDeviceImportRow(row int, in map[string]string) (deviceImportRow, error) {
+	for key := range in {
+		if !slices.Contains(deviceInventoryColumns, key) {
+			return deviceImportRow{}, fmt.Errorf(
+				"row %v: unsupported column `%s`, supported columns are %s",
+				row, key, strings.Join(deviceInventoryColumns, ", "),
+			)
+		}
+	}
+
+	return deviceImportRow{
+		Row:         row,
+		Name:        strings.TrimSpace(in["name"]),
+		Host:        strings.TrimSpace(in["host"]),
+		OsType:      strings.TrimSpace(in["ostype"]),
+		Adapter:     strings.TrimSpace(in["adapter"]),
+		Credentials: strings.TrimSpace(in["credentials"]),
+	}, nil
+}
+
+// readDeviceInventory reads the inventory file at path.  Files with a `.csv`
+// extension are read as CSV with a header row, all other files are read as
+// JSON or YAML.  The columns are validated before any rows are returned.
+func readDeviceInventory(path string) ([]deviceImportRow, error) {
+	logging.Trace()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ToLower(filepath.Ext(path)) == ".csv" {
+		return readDeviceInventoryCsv(b)
+	}
+
+	var data interface{}
+	if err := utils.UnmarshalData(b, &data); err != nil {
+		return nil, err
+	}
+
+	data = utils.NormalizeValue(data)
+
+	if m, ok := data.(map[string]interface{}); ok {
+		data = m["devices"]
+	}
+
+	items, ok := data.([]interface{})
+	if !ok {
+		return nil, errors.New("inventory must be a list of devices or an object with a `devices` list")
+	}
+
+	var rows []deviceImportRow
+
+	for i, ele := range items {
+		item, ok := ele.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("row %v: expected an object", i+1)
+		}
+
+		// null values are treated as empty so required fields that are
+		// set to null fail validation
+		values := map[string]string{}
+		for key, value := range item {
+			if value == nil {
+				values[strings.ToLower(key)] = ""
+			} else {
+				values[strings.ToLower(key)] = fmt.Sprint(value)
+			}
+		}
+
+		row, err := newDeviceImportRow(i+1, values)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// readDeviceInventoryCsv reads CSV data where the first line is the header
+// row.  The header must include all of the required columns.
+func readDeviceInventoryCsv(b []byte) ([]deviceImportRow, error) {
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("inventory file is empty")
+	}
+
+	var header []string
+	for _, ele := range records[0] {
+		header = append(header, strings.ToLower(strings.TrimSpace(ele)))
+	}
+
+	for _, ele := range deviceInventoryRequired {
+		if !slices.Contains(header, ele) {
+			return nil, fmt.Errorf("inventory is missing required column `%s`", ele)
+		}
+	}
+
+	var rows []deviceImportRow
+
+	for i, record := range records[1:] {
+		values := map[string]string{}
+		for j, key := range header {
+			values[key] = record[j]
+		}
+
+		row, err := newDeviceImportRow(i+2, values)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// deviceAdapter returns the name of the adapter that manages the device.  The
+// adapter is taken from the device origins and an empty string is returned
+// if it cannot be determined.
+func deviceAdapter(d *services.Device) string {
+	origins, ok := d.Origins.([]interface{})
+	if !ok || len(origins) == 0 {
+		return ""
+	}
+
+	switch v := origins[0].(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		for _, key := range []string{"adapter", "name", "id"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+
+	return ""
+}
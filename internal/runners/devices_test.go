@@ -0,0 +1,225 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceBackupToPath(t *testing.T) {
+	runner := NewDeviceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/devices",
+		`{"total": 3, "list": [{"name": "rtr1"}, {"name": "rtr2"}, {"name": "rtr3"}]}`,
+		200,
+	)
+	testlib.AddGetResponseToMux("/configuration_manager/devices/rtr1/configuration", `{"config": "hostname rtr1\n"}`, 0)
+	testlib.AddGetResponseToMux("/configuration_manager/devices/rtr2/configuration", `{"config": "hostname rtr2\n"}`, 0)
+	testlib.AddGetResponseToMux("/configuration_manager/devices/rtr3/configuration", `{"config": "hostname rtr3\n"}`, 0)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rtr1.cfg"), []byte("hostname rtr1\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rtr2.cfg"), []byte("hostname old\n"), 0644))
+
+	res, err := runner.Backup(Request{
+		Common:  &flags.AssetExportCommon{Path: dir},
+		Options: &flags.DeviceBackupOptions{},
+	})
+	require.NoError(t, err)
+
+	results := res.Object.([]deviceBackupResult)
+	require.Len(t, results, 3)
+	assert.Equal(t, "unchanged", results[0].Status)
+	assert.Equal(t, "changed", results[1].Status)
+	assert.Equal(t, "created", results[2].Status)
+
+	b, err := os.ReadFile(filepath.Join(dir, "rtr2.cfg"))
+	require.NoError(t, err)
+	assert.Equal(t, "hostname rtr2\n", string(b))
+	assert.Contains(t, res.Text, "3 device(s), 2 changed")
+}
+
+func writeInventory(t *testing.T, name, data string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	return path
+}
+
+func TestReadDeviceInventoryCsv(t *testing.T) {
+	path := writeInventory(t, "inventory.csv", "name,host,ostype,adapter,credentials\nrtr1,10.0.0.1,cisco-ios,nso,lab\nrtr2,10.0.0.2,cisco-ios,nso,\n")
+
+	rows, err := readDeviceInventory(path)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 2, rows[0].Row)
+	assert.Equal(t, "lab", rows[0].Credentials)
+	assert.Equal(t, "10.0.0.2", rows[1].Host)
+}
+
+func TestReadDeviceInventoryYaml(t *testing.T) {
+	path := writeInventory(t, "inventory.yaml", "devices:\n  - name: rtr1\n    host: 10.0.0.1\n    ostype: cisco-ios\n    adapter: nso\n")
+
+	rows, err := readDeviceInventory(path)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "rtr1", rows[0].Name)
+	assert.NoError(t, rows[0].validate())
+}
+
+func TestReadDeviceInventoryNull(t *testing.T) {
+	path := writeInventory(t, "inventory.json", `[{"name": "rtr1", "host": null, "ostype": "cisco-ios", "adapter": "nso", "credentials": null}]`)
+
+	rows, err := readDeviceInventory(path)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "", rows[0].Host)
+	assert.Equal(t, "", rows[0].Credentials)
+	assert.ErrorContains(t, rows[0].validate(), "host")
+}
+
+func TestReadDeviceInventoryInvalidColumns(t *testing.T) {
+	path := writeInventory(t, "missing.csv", "name,host,adapter\nrtr1,10.0.0.1,nso\n")
+	_, err := readDeviceInventory(path)
+	assert.ErrorContains(t, err, "ostype")
+
+	path = writeInventory(t, "unknown.csv", "name,host,ostype,adapter,site\nrtr1,10.0.0.1,ios,nso,nyc\n")
+	_, err = readDeviceInventory(path)
+	assert.ErrorContains(t, err, "site")
+}
+
+func TestDeviceImportDryRun(t *testing.T) {
+	runner := NewDeviceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/devices",
+		`{"total": 1, "list": [{"name": "rtr1", "origins": ["nso"]}]}`,
+		200,
+	)
+
+	path := writeInventory(t, "inventory.csv", "name,host,ostype,adapter\nrtr1,10.0.0.1,cisco-ios,nso\nrtr2,10.0.0.2,cisco-ios,nso\nrtr3,,cisco-ios,nso\n")
+
+	res, err := runner.Import(Request{
+		Args:    []string{path},
+		Common:  &flags.AssetImportCommon{},
+		Options: &flags.DeviceImportOptions{DryRun: true},
+	})
+
+	assert.Error(t, err)
+	require.NotNil(t, res)
+
+	results := res.Object.([]deviceImportResult)
+	require.Len(t, results, 3)
+	assert.Equal(t, "failed", results[0].Status)
+	assert.Contains(t, results[0].Message, "--replace")
+	assert.Equal(t, "created (dry run)", results[1].Status)
+	assert.Equal(t, "failed", results[2].Status)
+	assert.Contains(t, results[2].Message, "host")
+}
+
+func TestDeviceImportValidatesAllRows(t *testing.T) {
+	runner := NewDeviceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux("/configuration_manager/devices", `{"total": 0, "list": []}`, 200)
+
+	var created []string
+	testlib.AddHandlerToMux("POST /configuration_manager/adapters/{adapter}/devices", func(w http.ResponseWriter, r *http.Request) {
+		created = append(created, r.PathValue("adapter"))
+		w.Write([]byte(`{}`))
+	})
+
+	path := writeInventory(t, "inventory.csv", "name,host,ostype,adapter\nrtr1,10.0.0.1,cisco-ios,nso\nrtr2,,cisco-ios,nso\n")
+
+	res, err := runner.Import(Request{
+		Args:    []string{path},
+		Common:  &flags.AssetImportCommon{},
+		Options: &flags.DeviceImportOptions{},
+	})
+
+	assert.EqualError(t, err, "1 of 2 device(s) failed validation, no devices were imported")
+	require.NotNil(t, res)
+
+	results := res.Object.([]deviceImportResult)
+	require.Len(t, results, 2)
+	assert.Equal(t, "skipped", results[0].Status)
+	assert.Equal(t, "failed", results[1].Status)
+
+	// the valid row is not created because a later row is invalid
+	assert.Empty(t, created)
+}
+
+func TestDeviceImportReplaceRestoresDevice(t *testing.T) {
+	runner := NewDeviceRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/devices",
+		`{"total": 1, "list": [{"name": "rtr1", "host": "10.0.0.9", "ostype": "cisco-ios", "origins": ["nso"]}]}`,
+		200,
+	)
+
+	var requests []string
+	testlib.AddHandlerToMux("DELETE /configuration_manager/adapters/{adapter}/devices/{name}", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "delete "+r.PathValue("adapter"))
+		w.Write([]byte(`{}`))
+	})
+	testlib.AddHandlerToMux("POST /configuration_manager/adapters/{adapter}/devices", func(w http.ResponseWriter, r *http.Request) {
+		adapter := r.PathValue("adapter")
+		requests = append(requests, "create "+adapter)
+		if adapter == "missing" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	path := writeInventory(t, "inventory.csv", "name,host,ostype,adapter\nrtr1,10.0.0.1,cisco-ios,missing\n")
+
+	res, err := runner.Import(Request{
+		Args:    []string{path},
+		Common:  &flags.AssetImportCommon{Replace: true},
+		Options: &flags.DeviceImportOptions{},
+	})
+
+	assert.EqualError(t, err, "1 of 1 device(s) failed to import")
+	require.NotNil(t, res)
+
+	results := res.Object.([]deviceImportResult)
+	require.Len(t, results, 1)
+	assert.Equal(t, "failed", results[0].Status)
+	assert.Contains(t, results[0].Message, "the existing device was restored")
+
+	assert.Equal(t, []string{"delete nso", "create missing", "create nso"}, requests)
+}
+
+func TestDeviceAdapter(t *testing.T) {
+	assert.Equal(t, "nso", deviceAdapter(&services.Device{Origins: []interface{}{"nso"}}))
+	assert.Equal(t, "iag", deviceAdapter(&services.Device{Origins: []interface{}{map[string]interface{}{"adapter": "iag"}}}))
+	assert.Equal(t, "", deviceAdapter(&services.Device{}))
+}
@@ -215,7 +215,7 @@
 //	        return nil, err
 //	    }
 //	    // Runner handles CLI concerns (file/Git operations)
-//	    if err := exportAssetFromRequest(in, exported, filename); err != nil {
+//	    if err := exportAssetFromRequest(in, "project", exported, filename); err != nil {
 //	        return nil, err
 //	    }
 //	    return &Response{
@@ -12,8 +12,8 @@ import (
 // assetst to disk.  The Request object is used to dump either to local disk or
 // to a Git repository.  The objects arguments provides a map of assets to dump
 // to disk.  The key of the map must be the filename for the asset and the
-// value must be the object instance.
-func dumpAssets(in Request, objects map[string]interface{}) error {
+// value must be the object instance.  The kind is the kind of the assets.
+func dumpAssets(in Request, kind string, objects map[string]interface{}) error {
 	logging.Trace()
-	return exportAssets(in, objects)
+	return exportAssets(in, kind, objects)
 }
@@ -0,0 +1,223 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+)
+
+// expandedAsset holds the files written when an asset is exported with the
+// `--expand` flag.  Fields of the asset are moved out of the main document
+// into separate files and the main document references each file by name
+// using the field name with a `Filename` (single file) or `Filenames` (one
+// file per element) suffix.  String values are written as is so template
+// bodies can be reviewed as plain text, all other values are written as
+// JSON.
+type expandedAsset struct {
+	filename string
+	document map[string]interface{}
+	files    map[string]interface{}
+}
+
+// newExpandedAsset converts the asset of the given kind to a document that
+// will be written to the file fn.  When the request asks for canonical
+// output, the document is made canonical before any of its fields are moved
+// so the moved fields are treated the same as in a regular export.
+func newExpandedAsset(in Request, kind string, asset any, fn string) (*expandedAsset, error) {
+	logging.Trace()
+
+	value, err := canonicalAssetFromRequest(in, kind, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := toMap(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expandedAsset{
+		filename: normalizeFilename(fn),
+		document: document,
+		files:    map[string]interface{}{},
+	}, nil
+}
+
+// move writes the value of key to the file fn and replaces the value in the
+// main document with a reference to the file.
+func (e *expandedAsset) move(key, fn string) {
+	fn = normalizeFilename(fn)
+	e.files[fn] = e.document[key]
+	delete(e.document, key)
+	e.document[key+"Filename"] = fn
+}
+
+// split writes each element of key to the file returned by name and replaces
+// the value in the main document with the list of files.  Duplicate
+// filenames are suffixed with the element index so no element is lost.
+func (e *expandedAsset) split(key string, name func(int, any) string) {
+	elements, _ := e.document[key].([]interface{})
+
+	var filenames = []interface{}{}
+	var seen = map[string]bool{}
+
+	for idx, ele := range elements {
+		fn := normalizeFilename(name(idx, ele))
+		if seen[fn] {
+			ext := filepath.Ext(fn)
+			fn = fmt.Sprintf("%s.%d%s", strings.TrimSuffix(fn, ext), idx, ext)
+		}
+		seen[fn] = true
+
+		e.files[fn] = ele
+		filenames = append(filenames, fn)
+	}
+
+	delete(e.document, key)
+	e.document[key+"Filenames"] = filenames
+}
+
+// assets returns the files of the expanded asset keyed by filename in the
+// format expected by exportAssets.
+func (e *expandedAsset) assets() map[string]interface{} {
+	var res = map[string]interface{}{e.filename: e.document}
+
+	for fn, value := range e.files {
+		if s, ok := value.(string); ok {
+			res[fn] = []byte(s)
+		} else {
+			res[fn] = value
+		}
+	}
+
+	return res
+}
+
+// exportExpandedFromRequest writes the expanded asset to disk or to the
+// repository specified in the request.
+func exportExpandedFromRequest(in Request, e *expandedAsset) error {
+	logging.Trace()
+	return exportAssets(in, "", e.assets())
+}
+
+// importExpandedFromRequest loads the asset from the path in the request
+// into ptr.  Any of the fields listed in keys that were moved to separate
+// files by an expanded export are read from the directory of the main file
+// and put back into the document before it is decoded.  The assemble
+// function, when not nil, is called with the reassembled document so
+// callers can restore fields that were written in a different shape.
+func importExpandedFromRequest(in Request, ptr any, assemble func(map[string]interface{}) error, keys ...string) error {
+	logging.Trace()
+
+	path, err := importGetPathFromRequest(in)
+	if err != nil {
+		return err
+	}
+
+	if in.Common.(flags.Gitter).GetRepository() != "" {
+		defer os.RemoveAll(filepath.Dir(path))
+	}
+
+	var document map[string]interface{}
+
+	if err := importLoadFromDisk(path, &document); err != nil {
+		return err
+	}
+
+	if err := importExpandedFields(document, filepath.Dir(path), keys...); err != nil {
+		return err
+	}
+
+	if assemble != nil {
+		if err := assemble(document); err != nil {
+			return err
+		}
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, ptr)
+}
+
+// importExpandedFields replaces the file references in the document with
+// the contents of the files.  Files with a `.json` extension are decoded,
+// all other files are loaded as strings.
+func importExpandedFields(document map[string]interface{}, dir string, keys ...string) error {
+	logging.Trace()
+
+	for _, key := range keys {
+		if value, exists := document[key+"Filename"]; exists {
+			fn, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("field `%sFilename` must be a string", key)
+			}
+
+			v, err := importExpandedFile(dir, fn)
+			if err != nil {
+				return err
+			}
+
+			document[key] = v
+			delete(document, key+"Filename")
+		}
+
+		if value, exists := document[key+"Filenames"]; exists {
+			filenames, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("field `%sFilenames` must be a list of strings", key)
+			}
+
+			var elements = []interface{}{}
+
+			for _, ele := range filenames {
+				v, err := importExpandedFile(dir, fmt.Sprint(ele))
+				if err != nil {
+					return err
+				}
+				elements = append(elements, v)
+			}
+
+			document[key] = elements
+			delete(document, key+"Filenames")
+		}
+	}
+
+	return nil
+}
+
+// importExpandedFile loads a single file written by an expanded export.
+func importExpandedFile(dir, fn string) (any, error) {
+	fp := filepath.Join(dir, fn)
+
+	b, err := os.ReadFile(fp)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read file %q: an expanded asset must be imported together with the files it references: %w",
+			fp, err,
+		)
+	}
+
+	if !strings.EqualFold(filepath.Ext(fn), ".json") {
+		return string(b), nil
+	}
+
+	var res any
+	if err := utils.UnmarshalData(b, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse file %q: %w", fp, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeExpandedAsset writes the files of the expanded asset to dir the same
+// way exportAssets does.
+func writeExpandedAsset(t *testing.T, e *expandedAsset, dir string) {
+	for fn, value := range e.assets() {
+		b, ok := value.([]byte)
+		if !ok {
+			var err error
+			b, err = json.Marshal(value)
+			require.NoError(t, err)
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fn), b, 0644))
+	}
+}
+
+func TestTemplateExportExpand(t *testing.T) {
+	runner := NewTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+
+	template := testlib.Fixture("testdata/templates/template.json")
+
+	testlib.AddGetResponseToMux("/automation-studio/templates", `{"items": [`+template+`], "total": 1}`, 0)
+	testlib.AddGetResponseToMux("/automation-studio/templates/abc123/export", template, 0)
+
+	dir := t.TempDir()
+
+	_, err := runner.Export(Request{
+		Args:    []string{"test-template"},
+		Common:  &flags.AssetExportCommon{Path: dir},
+		Options: &flags.TemplateExportOptions{Expand: true},
+	})
+	testlib.Teardown()
+	require.NoError(t, err)
+
+	body, err := os.ReadFile(filepath.Join(dir, "test-template.j2"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello {{ name }}", string(body))
+
+	var document map[string]interface{}
+	require.NoError(t, importLoadFromDisk(filepath.Join(dir, "test-template.template.json"), &document))
+	assert.Equal(t, "test-template.j2", document["templateFilename"])
+	assert.NotContains(t, document, "template")
+
+	runner = NewTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	var imported map[string][]services.Template
+
+	testlib.AddGetResponseToMux("/automation-studio/templates", templatesGetAllEmpty, 0)
+	testlib.AddHandlerToMux("POST /automation-studio/templates/import", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &imported)
+		w.Write([]byte(templatesImportResponse))
+	})
+
+	_, err = runner.Import(Request{
+		Args:   []string{filepath.Join(dir, "test-template.template.json")},
+		Common: &flags.AssetImportCommon{},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, imported["templates"], 1)
+	assert.Equal(t, "Hello {{ name }}", imported["templates"][0].Template)
+	assert.Equal(t, "jinja2", imported["templates"][0].Type)
+}
+
+func TestExpandTransformation(t *testing.T) {
+	transformation := services.NewTransformation("hostname", "")
+	transformation.Incoming = []map[string]interface{}{{"$id": "site", "type": "string"}}
+	transformation.Outgoing = []map[string]interface{}{{"$id": "hostname", "type": "string"}}
+	transformation.Functions = []map[string]interface{}{
+		{"name": "lower", "code": "return x.toLowerCase()"},
+		{"code": "return x"},
+	}
+
+	expanded, err := expandTransformation(Request{}, &transformation, "hostname.transformation.json")
+	require.NoError(t, err)
+
+	assert.Equal(t, "hostname.schema.json", expanded.document["schemaFilename"])
+	assert.Equal(t, []interface{}{"hostname.function.lower.json", "hostname.function.1.json"}, expanded.document["functionsFilenames"])
+	assert.NotContains(t, expanded.document, "incoming")
+	assert.NotContains(t, expanded.document, "functions")
+
+	dir := t.TempDir()
+	writeExpandedAsset(t, expanded, dir)
+
+	var res services.Transformation
+	err = importExpandedFromRequest(Request{
+		Args:   []string{filepath.Join(dir, "hostname.transformation.json")},
+		Common: &flags.AssetImportCommon{},
+	}, &res, assembleTransformation, "schema", "functions")
+	require.NoError(t, err)
+
+	assert.Equal(t, transformation.Incoming, res.Incoming)
+	assert.Equal(t, transformation.Outgoing, res.Outgoing)
+	assert.Equal(t, transformation.Functions, res.Functions)
+}
+
+func TestExpandedAssetSplitDuplicates(t *testing.T) {
+	expanded, err := newExpandedAsset(Request{}, "automation", map[string]interface{}{
+		"name":     "nightly",
+		"triggers": []interface{}{"a", "b", "c"},
+	}, "nightly.automation.json")
+	require.NoError(t, err)
+
+	expanded.split("triggers", func(idx int, ele any) string {
+		return "nightly.trigger.json"
+	})
+
+	assert.Equal(t, []interface{}{
+		"nightly.trigger.json",
+		"nightly.trigger.1.json",
+		"nightly.trigger.2.json",
+	}, expanded.document["triggersFilenames"])
+	assert.Len(t, expanded.assets(), 4)
+}
+
+func TestImportExpandedFieldsMissingFile(t *testing.T) {
+	document := map[string]interface{}{"schemaFilename": "missing.schema.json"}
+
+	err := importExpandedFields(document, t.TempDir(), "schema")
+	assert.ErrorContains(t, err, "an expanded asset must be imported together with the files it references")
+}
@@ -93,47 +93,52 @@ func exportNewRepositoryFromRequest(in Request) (*Repository, error) {
 // exportAssetFromRequest will take a request object and instance of an asset
 // and write it to disk.  If the Git command line options where invoked, it
 // will write the asset to the repository and commit it.  If not, this function
-// will simply write the asset to the local disk.
-func exportAssetFromRequest(in Request, o any, fn string) error {
-	return exportAssets(in, map[string]interface{}{fn: o})
+// will simply write the asset to the local disk.  The kind is the kind of
+// the asset, for instance `workflow`.
+func exportAssetFromRequest(in Request, kind string, o any, fn string) error {
+	return exportAssets(in, kind, map[string]interface{}{fn: o})
 }
 
 // exportAssets accepts the Request object and a map of the assets and will
 // write them to disk.  If the request object includes repository settings,
 // this function will push the assets into the repository.  The assets argument
 // must be a map where the key is the filename and the value is the asset to
-// write to disk.
-func exportAssets(in Request, assets map[string]interface{}) error {
+// write to disk.  Assets are encoded as JSON unless the value is a []byte, in
+// which case it is written as is.  When the request asks for canonical
+// output, the server managed fields for the asset kind are removed from each
+// JSON asset.  Callers that write assets of mixed kinds, or assets that are
+// already canonical, pass an empty kind and the assets are written as is.
+func exportAssets(in Request, kind string, assets map[string]interface{}) error {
 	logging.Trace()
 
-	path := in.Common.(flags.Committer).GetPath()
-
-	var repo *Repository
-	var repoPath string
-
-	if in.Common.(flags.Gitter).GetRepository() != "" {
-		var e error
-
-		repo, e = exportNewRepositoryFromRequest(in)
-		if e != nil {
-			return e
-		}
+	repo, repoPath, path, err := exportTargetFromRequest(in)
+	if err != nil {
+		return err
+	}
 
-		repoPath, e = repo.Clone(
-			&FileReaderImpl{},
-			&ClonerImpl{},
-		)
-		if e != nil {
-			return e
-		}
+	if repo != nil {
 		defer os.RemoveAll(repoPath)
-
-		path = filepath.Join(repoPath, in.Common.(flags.Committer).GetPath())
 	}
 
 	for key, value := range assets {
-		if err := utils.WriteJsonToDisk(value, key, path); err != nil {
-			return err
+		if b, ok := value.([]byte); ok {
+			dst, err := utils.NormalizeFilename(key, path)
+			if err != nil {
+				return err
+			}
+			if err := utils.WriteBytesToDisk(b, dst, true); err != nil {
+				return err
+			}
+		} else {
+			if kind != "" {
+				var err error
+				if value, err = canonicalAssetFromRequest(in, kind, value); err != nil {
+					return err
+				}
+			}
+			if err := utils.WriteJsonToDisk(value, key, path); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -146,3 +151,34 @@ func exportAssets(in Request, assets map[string]interface{}) error {
 
 	return nil
 }
+
+// exportTargetFromRequest resolves where exported files should be written.
+// When the request includes repository settings, the repository is cloned
+// and the returned path points into the working tree of the clone.  The
+// caller is responsible for removing repoPath once it is done with the
+// repository.  When no repository is set, repo is nil and path is the local
+// path from the request.
+func exportTargetFromRequest(in Request) (repo *Repository, repoPath string, path string, err error) {
+	logging.Trace()
+
+	path = in.Common.(flags.Committer).GetPath()
+
+	if in.Common.(flags.Gitter).GetRepository() == "" {
+		return nil, "", path, nil
+	}
+
+	repo, err = exportNewRepositoryFromRequest(in)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	repoPath, err = repo.Clone(
+		&FileReaderImpl{},
+		&ClonerImpl{},
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return repo, repoPath, filepath.Join(repoPath, path), nil
+}
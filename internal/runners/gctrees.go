@@ -161,7 +161,7 @@ func (r *GoldenConfigRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.gctree.json", res.Name)
 
-	if err := exportAssetFromRequest(in, res, fn); err != nil {
+	if err := exportAssetFromRequest(in, "gctree", res, fn); err != nil {
 		return nil, err
 	}
 
@@ -171,6 +171,169 @@ func (r *GoldenConfigRunner) Export(in Request) (*Response, error) {
 	}, nil
 }
 
+/*
+******************************************************************************
+Adder interface
+******************************************************************************
+*/
+
+// Add implements the `add gctree-node <tree> <path>` command.  The last
+// element of the path is the name of the new node and the remaining elements
+// identify the parent node.
+func (r *GoldenConfigRunner) Add(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.GoldenConfigNodeOptions)
+
+	name := in.Args[0]
+	nodePath := strings.Trim(in.Args[1], "/")
+
+	idx := strings.LastIndex(nodePath, "/")
+	if idx < 0 {
+		return nil, fmt.Errorf("node path `%s` must include the parent node, for example `base/%s`", nodePath, nodePath)
+	}
+
+	parentPath, nodeName := nodePath[:idx], nodePath[idx+1:]
+
+	tree, version, err := r.getTreeVersion(name, options.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := findTreeNode(tree.Root, parentPath); err != nil {
+		return nil, err
+	}
+
+	if _, err := findTreeNode(tree.Root, nodePath); err == nil {
+		return nil, fmt.Errorf("node `%s` already exists in gctree `%s`", nodePath, name)
+	}
+
+	res, err := r.service.AddNode(tree.TreeId, version, parentPath, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully added node `%s` to gctree `%s`", nodePath, name),
+		Object: res,
+	}, nil
+}
+
+/*
+******************************************************************************
+Remover interface
+******************************************************************************
+*/
+
+// Remove implements the `remove gctree-node <tree> <path>` command
+func (r *GoldenConfigRunner) Remove(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.GoldenConfigNodeOptions)
+
+	name := in.Args[0]
+	nodePath := strings.Trim(in.Args[1], "/")
+
+	if !strings.Contains(nodePath, "/") {
+		return nil, fmt.Errorf("the root node `%s` cannot be removed", nodePath)
+	}
+
+	tree, version, err := r.getTreeVersion(name, options.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := findTreeNode(tree.Root, nodePath); err != nil {
+		return nil, err
+	}
+
+	if err := r.service.DeleteNode(tree.TreeId, version, nodePath); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully removed node `%s` from gctree `%s`", nodePath, name),
+	}, nil
+}
+
+/*
+******************************************************************************
+Setter interface
+******************************************************************************
+*/
+
+// Set implements the `set gctree-variables <tree> <path> --vars ...` command.
+// The variables replace any variables currently defined for the node.
+func (r *GoldenConfigRunner) Set(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.GoldenConfigVariablesOptions)
+
+	name := in.Args[0]
+	nodePath := strings.Trim(in.Args[1], "/")
+
+	vars, err := readArgVariables(options.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, version, err := r.getTreeVersion(name, options.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := findTreeNode(tree.Root, nodePath); err != nil {
+		return nil, err
+	}
+
+	if err := r.service.UpdateNodeVariables(tree.TreeId, version, nodePath, vars); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully set variables for node `%s` in gctree `%s`", nodePath, name),
+		Object: vars,
+	}, nil
+}
+
+/*
+******************************************************************************
+Attacher interface
+******************************************************************************
+*/
+
+// Attach implements the `attach gctree-devices <tree> <path> --device ...`
+// command
+func (r *GoldenConfigRunner) Attach(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.GoldenConfigDevicesOptions)
+
+	name := in.Args[0]
+	nodePath := strings.Trim(in.Args[1], "/")
+
+	tree, version, err := r.getTreeVersion(name, options.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := findTreeNode(tree.Root, nodePath); err != nil {
+		return nil, err
+	}
+
+	if err := r.service.AddDevicesToNode(tree.TreeId, version, nodePath, options.Devices); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf(
+			"Successfully attached %v device(s) to node `%s` in gctree `%s`",
+			len(options.Devices), nodePath, name,
+		),
+		Object: options.Devices,
+	}, nil
+}
+
 /*
 *******************************************************************************
 Private functions
@@ -214,3 +377,67 @@ func (r *GoldenConfigRunner) getTreeIdFromName(name string) (string, error) {
 
 	return "", errors.New(fmt.Sprintf("Unable to find tree with name %s", name))
 }
+
+// getTreeVersion returns the tree version for the named tree.  If version is
+// empty, the latest version of the tree is returned.
+func (r *GoldenConfigRunner) getTreeVersion(name, version string) (*services.GoldenConfigTree, string, error) {
+	logging.Trace()
+
+	summary, err := r.service.GetByName(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if version == "" {
+		if len(summary.Versions) == 0 {
+			return nil, "", fmt.Errorf("gctree `%s` does not have any versions", name)
+		}
+		version = summary.Versions[len(summary.Versions)-1]
+	}
+
+	tree, err := r.service.GetVersion(summary.Id, version)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The version document carries its own id, so the tree id from the
+	// summary is used for all node operations.
+	tree.TreeId = summary.Id
+
+	return tree, version, nil
+}
+
+// findTreeNode walks the tree starting at node and returns the node
+// identified by path.  The first element of the path must match the name of
+// the starting node and each remaining element is the name of a child node.
+func findTreeNode(node map[string]interface{}, path string) (map[string]interface{}, error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if name, _ := node["name"].(string); name != parts[0] {
+		return nil, fmt.Errorf("node `%s` not found", path)
+	}
+
+	for _, part := range parts[1:] {
+		var next map[string]interface{}
+
+		children, _ := node["children"].([]interface{})
+		for _, ele := range children {
+			child, ok := ele.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, _ := child["name"].(string); name == part {
+				next = child
+				break
+			}
+		}
+
+		if next == nil {
+			return nil, fmt.Errorf("node `%s` not found", path)
+		}
+
+		node = next
+	}
+
+	return node, nil
+}
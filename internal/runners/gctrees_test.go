@@ -0,0 +1,109 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+var gctreeVersionResponse = `{
+	"_id": "v1",
+	"name": "ios",
+	"version": "initial",
+	"root": {
+		"id": "n1",
+		"name": "base",
+		"children": [
+			{"id": "n2", "name": "ntp", "children": []}
+		]
+	}
+}`
+
+func TestFindTreeNode(t *testing.T) {
+	root := map[string]interface{}{
+		"id":   "n1",
+		"name": "base",
+		"children": []interface{}{
+			map[string]interface{}{
+				"id":   "n2",
+				"name": "ntp",
+				"children": []interface{}{
+					map[string]interface{}{"id": "n3", "name": "servers"},
+				},
+			},
+		},
+	}
+
+	node, err := findTreeNode(root, "base/ntp/servers")
+	assert.NoError(t, err)
+	assert.Equal(t, "n3", node["id"])
+
+	node, err = findTreeNode(root, "base")
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", node["id"])
+
+	_, err = findTreeNode(root, "base/snmp")
+	assert.Error(t, err)
+
+	_, err = findTreeNode(root, "other/ntp")
+	assert.Error(t, err)
+}
+
+func TestGoldenConfigAddNode(t *testing.T) {
+	runner := NewGoldenConfigRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/configuration_manager/configs", `[{"id": "tree1", "name": "ios", "versions": ["initial"]}]`, 0)
+	testlib.AddGetResponseToMux("/configuration_manager/configs/tree1/initial", gctreeVersionResponse, 0)
+	testlib.AddPostResponseToMux("/configuration_manager/configs/tree1/initial/base", `{"id": "n3", "name": "snmp"}`, 200)
+
+	res, err := runner.Add(Request{
+		Args:    []string{"ios", "base/snmp"},
+		Options: &flags.GoldenConfigNodeOptions{},
+	})
+
+	assert.NoError(t, err)
+	assert.Contains(t, res.Text, "base/snmp")
+}
+
+func TestGoldenConfigAddNodeExists(t *testing.T) {
+	runner := NewGoldenConfigRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/configuration_manager/configs", `[{"id": "tree1", "name": "ios", "versions": ["initial"]}]`, 0)
+	testlib.AddGetResponseToMux("/configuration_manager/configs/tree1/initial", gctreeVersionResponse, 0)
+
+	_, err := runner.Add(Request{
+		Args:    []string{"ios", "base/ntp"},
+		Options: &flags.GoldenConfigNodeOptions{},
+	})
+
+	assert.Error(t, err)
+}
+
+func TestGoldenConfigRemoveRootNode(t *testing.T) {
+	runner := NewGoldenConfigRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	_, err := runner.Remove(Request{
+		Args:    []string{"ios", "base"},
+		Options: &flags.GoldenConfigNodeOptions{},
+	})
+
+	assert.Error(t, err)
+}
@@ -256,7 +256,7 @@ func (r *GroupRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.group.json", name)
 
-	if err := exportAssetFromRequest(in, grp, fn); err != nil {
+	if err := exportAssetFromRequest(in, "group", grp, fn); err != nil {
 		return nil, err
 	}
 
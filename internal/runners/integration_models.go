@@ -169,7 +169,7 @@ func (r *IntegrationModelRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.integration_model.json", name)
 
-	if err := exportAssetFromRequest(in, res, fn); err != nil {
+	if err := exportAssetFromRequest(in, "integration_model", res, fn); err != nil {
 		return nil, err
 	}
 
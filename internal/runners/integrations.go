@@ -219,7 +219,7 @@ func (r *IntegrationRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.integration.json", name)
 
-	if err := exportAssetFromRequest(in, res, fn); err != nil {
+	if err := exportAssetFromRequest(in, "integration", res, fn); err != nil {
 		return nil, err
 	}
 
@@ -61,6 +61,62 @@ type Loader interface {
 	Load(Request) (*Response, error)
 }
 
+type Renderer interface {
+	Render(Request) (*Response, error)
+}
+
+type Backuper interface {
+	Backup(Request) (*Response, error)
+}
+
+type Executor interface {
+	Run(Request) (*Response, error)
+}
+
+type Adder interface {
+	Add(Request) (*Response, error)
+}
+
+type Remover interface {
+	Remove(Request) (*Response, error)
+}
+
+type Setter interface {
+	Set(Request) (*Response, error)
+}
+
+type Attacher interface {
+	Attach(Request) (*Response, error)
+}
+
+type Syncer interface {
+	Sync(Request) (*Response, error)
+}
+
+type Tester interface {
+	Test(Request) (*Response, error)
+}
+
+type Linter interface {
+	Lint(Request) (*Response, error)
+}
+
+type Renamer interface {
+	Rename(Request) (*Response, error)
+}
+
+type Refactorer interface {
+	Refactor(Request) (*Response, error)
+}
+
+type Tagger interface {
+	Tag(Request) (*Response, error)
+}
+
+type Migrater interface {
+	Migrate(Request) (*Response, error)
+}
+
 type FileReader interface {
 	Read(path string) ([]byte, error)
 }
@@ -250,7 +250,7 @@ func (r *JsonFormRunner) Import(in Request) (*Response, error) {
 
 	var res services.JsonForm
 
-	if err := importUnmarshalFromRequest(in, &res); err != nil {
+	if err := importExpandedFromRequest(in, &res, nil, "schema", "uiSchema", "bindingSchema"); err != nil {
 		return nil, err
 	}
 
@@ -273,9 +273,6 @@ func (r *JsonFormRunner) Import(in Request) (*Response, error) {
 func (r *JsonFormRunner) Export(in Request) (*Response, error) {
 	logging.Trace()
 
-	var options *flags.AssetExportCommon
-	utils.LoadObject(in.Common, &options)
-
 	name := in.Args[0]
 
 	jsonform, err := r.resource.GetByName(name)
@@ -285,7 +282,20 @@ func (r *JsonFormRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.jsonform.json", name)
 
-	if err := utils.WriteJsonToDisk(jsonform, fn, options.Path); err != nil {
+	if options, ok := in.Options.(*flags.JsonFormExportOptions); ok && options.Expand {
+		expanded, err := newExpandedAsset(in, "jsonform", jsonform, fn)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded.move("schema", fmt.Sprintf("%s.schema.json", jsonform.Name))
+		expanded.move("uiSchema", fmt.Sprintf("%s.uischema.json", jsonform.Name))
+		expanded.move("bindingSchema", fmt.Sprintf("%s.bindings.json", jsonform.Name))
+
+		if err := exportExpandedFromRequest(in, expanded); err != nil {
+			return nil, err
+		}
+	} else if err := exportAssetFromRequest(in, "jsonform", jsonform, fn); err != nil {
 		return nil, err
 	}
 
@@ -294,6 +304,42 @@ func (r *JsonFormRunner) Export(in Request) (*Response, error) {
 	}, nil
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Renamer Interface
+//
+
+// Rename implements the `rename jsonform <old> <new>` command.  Workflows
+// and automations reference JSON forms by id so only the form itself is
+// updated.
+func (r *JsonFormRunner) Rename(in Request) (*Response, error) {
+	logging.Trace()
+
+	from, to := in.Args[0], in.Args[1]
+
+	if _, err := r.resource.GetByName(to); err == nil {
+		return nil, fmt.Errorf("json form `%s` already exists", to)
+	}
+
+	current, err := r.resource.GetByName(from)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := services.NewJsonFormService(r.client)
+	plan := newRenamePlan(dependencyJsonForm, from, to)
+
+	before := *current
+	after := *current
+	after.Name = to
+
+	plan.add(dependencyJsonForm, from, before, after,
+		func() error { _, err := svc.Update(after); return err },
+		func() error { _, err := svc.Update(before); return err },
+	)
+
+	return renameResponse(in, plan)
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Private functions
 //
@@ -0,0 +1,308 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/migrations"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// platformDependencies are the keys in the server health dependencies that
+// hold the platform version, newest first.
+var platformDependencies = []string{"itential-platform", "@itential/pronghorn-core"}
+
+// AssetMigrationRunner implements the `migrate assets` command.
+type AssetMigrationRunner struct {
+	BaseRunner
+}
+
+func NewAssetMigrationRunner(c client.Client, cfg config.Provider) *AssetMigrationRunner {
+	return &AssetMigrationRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+	}
+}
+
+// migratedAsset is the report for a single migrated file.
+type migratedAsset struct {
+	Path    string              `json:"path"`
+	Kind    string              `json:"kind"`
+	Changes []migrations.Change `json:"changes"`
+}
+
+// Migrate implements the `migrate assets <path> --from-version <version>
+// --to-version <version>` command.  Every exported workflow, automation,
+// trigger, template and project file found in path is converted to the
+// format used by the target version and rewritten in place.  Files with any
+// other kind are left unchanged.
+func (r *AssetMigrationRunner) Migrate(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.MigrateAssetsOptions)
+
+	if _, err := migrations.Required(options.FromVersion, options.ToVersion); err != nil {
+		return nil, err
+	}
+
+	from, _ := migrations.Release(options.FromVersion)
+	to, _ := migrations.Release(options.ToVersion)
+
+	files, err := migrationFiles(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var report []migratedAsset
+	var output []string
+
+	for _, fn := range files {
+		kind := migrationKind(fn)
+
+		b, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, err
+		}
+
+		var doc map[string]interface{}
+		if err := json.Unmarshal(b, &doc); err != nil {
+			logging.Warn("skipping `%s`, file is not a JSON object", fn)
+			continue
+		}
+
+		changes, err := migrations.Migrate(kind, doc, options.FromVersion, options.ToVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		if !options.DryRun {
+			if err := utils.WriteJsonToDisk(doc, filepath.Base(fn), filepath.Dir(fn)); err != nil {
+				return nil, err
+			}
+		}
+
+		report = append(report, migratedAsset{Path: fn, Kind: kind, Changes: changes})
+
+		output = append(output, fmt.Sprintf("%s (%s)", fn, kind))
+		for _, ele := range changes {
+			output = append(output, fmt.Sprintf("    %s", ele))
+		}
+	}
+
+	summary := fmt.Sprintf("Migrated %v of %v asset(s) from %s to %s", len(report), len(files), from, to)
+	if options.DryRun {
+		summary = fmt.Sprintf("Dry run, %v of %v asset(s) would be migrated from %s to %s", len(report), len(files), from, to)
+	}
+
+	if len(output) > 0 {
+		output = append(output, "")
+	}
+	output = append(output, summary)
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: report,
+	}, nil
+}
+
+// migrationFiles returns the sorted list of files in path that contain an
+// asset that can be migrated.  Path can be a single file or a directory
+// that is searched recursively.
+func migrationFiles(path string) ([]string, error) {
+	logging.Trace()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if migrationKind(path) == "" {
+			return nil, fmt.Errorf("unable to determine the asset kind of `%s`", path)
+		}
+		return []string{path}, nil
+	}
+
+	var files []string
+
+	err = filepath.WalkDir(path, func(fn string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if migrationKind(fn) != "" {
+			files = append(files, fn)
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+// migrationKind returns the asset kind of an exported file based on its name,
+// for instance `backup.workflow.json` or `backup.trigger.nightly.json`.  An
+// empty string is returned when the file does not hold an asset that can be
+// migrated.
+func migrationKind(fn string) string {
+	name := strings.ToLower(filepath.Base(fn))
+
+	if !strings.HasSuffix(name, ".json") {
+		return ""
+	}
+
+	name = strings.TrimSuffix(name, ".json")
+
+	if strings.Contains(name, ".trigger.") {
+		return migrations.KindTrigger
+	}
+
+	idx := strings.LastIndex(name, ".")
+	if idx < 0 {
+		return ""
+	}
+
+	if kind := name[idx+1:]; migrations.Supported(kind) {
+		return kind
+	}
+
+	return ""
+}
+
+// copyMigration converts assets copied between servers running different
+// platform releases.  A nil copyMigration leaves assets unchanged.
+type copyMigration struct {
+	from string
+	to   string
+}
+
+// newCopyMigration returns the migration required to copy assets from the
+// server of the profile from to the server of the profile to.  It returns
+// nil when the servers run the same release or when the release of either
+// server can not be determined.
+func newCopyMigration(from, to string, cfg config.ProfileProvider) *copyMigration {
+	logging.Trace()
+
+	src, err := profileRelease(from, cfg)
+	if err != nil {
+		logging.Debug("unable to determine the platform version of `%s`: %s", from, err)
+		return nil
+	}
+
+	dst, err := profileRelease(to, cfg)
+	if err != nil {
+		logging.Debug("unable to determine the platform version of `%s`: %s", to, err)
+		return nil
+	}
+
+	required, err := migrations.Required(src, dst)
+	if err != nil {
+		logging.Warn("assets will be copied without migration: %s", err)
+		return nil
+	}
+
+	if !required {
+		return nil
+	}
+
+	return &copyMigration{from: src, to: dst}
+}
+
+// apply returns a copy of asset converted to the format of the destination
+// release.  The returned value has the same type as asset.
+func (m *copyMigration) apply(kind string, asset any) (any, error) {
+	logging.Trace()
+
+	if m == nil || !migrations.Supported(kind) {
+		return asset, nil
+	}
+
+	doc, err := toMap(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := migrations.Migrate(kind, doc, m.from, m.to)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 {
+		return asset, nil
+	}
+
+	for _, ele := range changes {
+		logging.Info("migrating %s from %s to %s: %s", kind, m.from, m.to, ele)
+	}
+
+	t := reflect.TypeOf(asset)
+	isPtr := t.Kind() == reflect.Ptr
+	if isPtr {
+		t = t.Elem()
+	}
+
+	res := reflect.New(t)
+	if err := utils.ToMap(doc, res.Interface()); err != nil {
+		return nil, err
+	}
+
+	if isPtr {
+		return res.Interface(), nil
+	}
+
+	return res.Elem().Interface(), nil
+}
+
+// profileRelease returns the platform release of the server for the named
+// profile.
+func profileRelease(name string, cfg config.ProfileProvider) (string, error) {
+	logging.Trace()
+
+	c, cancel, err := NewClient(name, cfg)
+	if err != nil {
+		return "", err
+	}
+	defer cancel()
+
+	return platformRelease(c)
+}
+
+// platformRelease returns the platform release of the server using the
+// version reported in the server health dependencies.
+func platformRelease(c client.Client) (string, error) {
+	logging.Trace()
+
+	health, err := services.NewHealthService(c).GetServerHealth()
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range platformDependencies {
+		if version, exists := health.Dependencies[key]; exists {
+			return migrations.Release(version)
+		}
+	}
+
+	return "", fmt.Errorf("server did not report a platform version")
+}
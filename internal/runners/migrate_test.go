@@ -0,0 +1,156 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMigrationFile(t *testing.T, dir, name, content string) string {
+	fn := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(fn), 0755))
+	require.NoError(t, os.WriteFile(fn, []byte(content), 0644))
+	return fn
+}
+
+func readMigrationFile(t *testing.T, fn string) map[string]interface{} {
+	b, err := os.ReadFile(fn)
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &doc))
+	return doc
+}
+
+func TestMigrationKind(t *testing.T) {
+	for fn, expected := range map[string]string{
+		"backup.workflow.json":        "workflow",
+		"dir/Backup.Automation.json":  "automation",
+		"backup.trigger.nightly.json": "trigger",
+		"show.version.template.json":  "template",
+		"Provisioning.project.json":   "project",
+		"jst.transformation.json":     "",
+		"backup.workflow.yaml":        "",
+		"README.json":                 "",
+	} {
+		assert.Equal(t, expected, migrationKind(fn), fn)
+	}
+}
+
+func TestAssetMigrationRunnerMigrate(t *testing.T) {
+	runner := NewAssetMigrationRunner(nil, testlib.DefaultConfig())
+
+	dir := t.TempDir()
+
+	wf := writeMigrationFile(t, dir, "backup.workflow.json", `{"name": "backup", "canvasVersion": 2}`)
+	current := writeMigrationFile(t, dir, "nested/current.workflow.json",
+		`{"name": "current", "canvasVersion": 3, "encodingVersion": 1, "type": "automation"}`)
+	trigger := writeMigrationFile(t, dir, "nested/backup.trigger.nightly.json", `{"name": "nightly", "migrationVersion": 2}`)
+	jst := writeMigrationFile(t, dir, "jst.transformation.json", `{"name": "jst"}`)
+
+	res, err := runner.Migrate(Request{
+		Args:    []string{dir},
+		Options: &flags.MigrateAssetsOptions{FromVersion: "2023.1", ToVersion: "2023.2.9", DryRun: true},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "Dry run, 2 of 3 asset(s) would be migrated from 2023.1 to 2023.2")
+	assert.Equal(t, float64(2), readMigrationFile(t, wf)["canvasVersion"])
+
+	res, err = runner.Migrate(Request{
+		Args:    []string{dir},
+		Options: &flags.MigrateAssetsOptions{FromVersion: "2023.1", ToVersion: "2023.2.9"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, wf+" (workflow)\n"+
+		"    [2023.2] canvasVersion: 2 -> 3\n"+
+		"    [2023.2] encodingVersion: added 1\n"+
+		"    [2023.2] type: added automation\n"+
+		trigger+" (trigger)\n"+
+		"    [2023.2] migrationVersion: 2 -> 3\n"+
+		"\n"+
+		"Migrated 2 of 3 asset(s) from 2023.1 to 2023.2", res.Text)
+
+	report := res.Object.([]migratedAsset)
+	require.Len(t, report, 2)
+	assert.Equal(t, "workflow", report[0].Kind)
+
+	assert.Equal(t, float64(3), readMigrationFile(t, wf)["canvasVersion"])
+	assert.Equal(t, float64(3), readMigrationFile(t, trigger)["migrationVersion"])
+
+	// unchanged and unsupported files are not rewritten
+	b, err := os.ReadFile(current)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name": "current", "canvasVersion": 3, "encodingVersion": 1, "type": "automation"}`, string(b))
+
+	b, err = os.ReadFile(jst)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name": "jst"}`, string(b))
+}
+
+func TestAssetMigrationRunnerMigrateErrors(t *testing.T) {
+	runner := NewAssetMigrationRunner(nil, testlib.DefaultConfig())
+
+	dir := t.TempDir()
+
+	_, err := runner.Migrate(Request{
+		Args:    []string{dir},
+		Options: &flags.MigrateAssetsOptions{FromVersion: "2023.2", ToVersion: "2023.1"},
+	})
+	assert.EqualError(t, err, "migrating assets from 2023.2 to 2023.1 is not supported")
+
+	fn := writeMigrationFile(t, dir, "notes.json", `{}`)
+
+	_, err = runner.Migrate(Request{
+		Args:    []string{fn},
+		Options: &flags.MigrateAssetsOptions{FromVersion: "2023.1", ToVersion: "2023.2"},
+	})
+	assert.EqualError(t, err, "unable to determine the asset kind of `"+fn+"`")
+}
+
+func TestPlatformRelease(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/health/server", `{"dependencies": {"itential-platform": "2023.2.9"}}`, 0)
+
+	res, err := platformRelease(client)
+	require.NoError(t, err)
+	assert.Equal(t, "2023.2", res)
+}
+
+func TestCopyMigrationApply(t *testing.T) {
+	wf := services.NewWorkflow("backup")
+	wf.CanvasVersion = 2
+
+	res, err := (&copyMigration{from: "2023.1", to: "2023.2"}).apply(dependencyWorkflow, wf)
+	require.NoError(t, err)
+
+	migrated, ok := res.(services.Workflow)
+	require.True(t, ok)
+	assert.Equal(t, float64(3), migrated.CanvasVersion)
+	assert.Equal(t, 1, migrated.EncodingVersion)
+	assert.Equal(t, float64(2), wf.CanvasVersion)
+
+	// a nil migration leaves the asset unchanged
+	var none *copyMigration
+	res, err = none.apply(dependencyWorkflow, wf)
+	require.NoError(t, err)
+	assert.Equal(t, wf, res)
+
+	// kinds without migrations are copied as is
+	jst := services.Transformation{Name: "jst"}
+	res, err = (&copyMigration{from: "2023.1", to: "2023.2"}).apply(dependencyTransformation, jst)
+	require.NoError(t, err)
+	assert.Equal(t, jst, res)
+}
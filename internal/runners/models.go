@@ -418,7 +418,7 @@ func (r *ModelRunner) Export(in Request) (*Response, error) {
 	} else {
 		fn := fmt.Sprintf("%s.model.json", normalizeFilename(model.Name))
 
-		if err := exportAssetFromRequest(in, model, fn); err != nil {
+		if err := exportAssetFromRequest(in, "model", model, fn); err != nil {
 			return nil, err
 		}
 	}
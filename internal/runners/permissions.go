@@ -0,0 +1,356 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// permissionAsset is a single asset whose read and write groups are managed
+// by the permissions commands.
+type permissionAsset struct {
+	Kind   string
+	Name   string
+	Id     string
+	Gbac   services.Gbac
+	update func(services.Gbac) error
+}
+
+// PermissionRunner implements the `get permissions` and `set permissions`
+// commands for Automation Studio assets.
+type PermissionRunner struct {
+	BaseRunner
+}
+
+func NewPermissionRunner(c client.Client, cfg config.Provider) *PermissionRunner {
+	return &PermissionRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+	}
+}
+
+// Get implements the `get permissions <kind> <name>` command
+func (r *PermissionRunner) Get(in Request) (*Response, error) {
+	logging.Trace()
+
+	if len(in.Args) != 2 {
+		return nil, errors.New("requires two arguments, the asset type and name")
+	}
+
+	kind, name := in.Args[0], in.Args[1]
+
+	assets, err := r.permissionAssets(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := findPermissionAsset(assets, kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := r.groupNames()
+	if err != nil {
+		return nil, err
+	}
+
+	read := permissionGroupNames(asset.Gbac.Read, groups)
+	write := permissionGroupNames(asset.Gbac.Write, groups)
+
+	return &Response{
+		Text: fmt.Sprintf("Read:  %s\nWrite: %s", permissionGroupList(read), permissionGroupList(write)),
+		Object: map[string]interface{}{
+			"kind":  asset.Kind,
+			"name":  asset.Name,
+			"read":  read,
+			"write": write,
+		},
+	}, nil
+}
+
+// Describe is not supported for permissions
+func (r *PermissionRunner) Describe(in Request) (*Response, error) {
+	return notImplemented(in)
+}
+
+// Set implements the `set permissions <kind> [<name>]` command.  The groups
+// are validated before any asset is updated.  Only the permissions specified
+// on the command line are changed so `--read` can be updated without
+// changing the write groups and the other way around.
+func (r *PermissionRunner) Set(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.PermissionSetOptions)
+
+	if options.Read == nil && options.Write == nil {
+		return nil, errors.New("at least one of `--read` or `--write` must be specified")
+	}
+
+	if len(in.Args) == 0 {
+		return nil, errors.New("requires the asset type as the first argument")
+	}
+
+	kind := in.Args[0]
+
+	var name string
+	if len(in.Args) > 1 {
+		name = in.Args[1]
+	}
+
+	var selectors int
+	for _, ele := range []string{name, options.AllInProject, options.Selector} {
+		if ele != "" {
+			selectors++
+		}
+	}
+
+	if selectors != 1 {
+		return nil, errors.New("specify exactly one of an asset name, `--all-in-project` or `--selector`")
+	}
+
+	assets, err := r.permissionAssets(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []permissionAsset
+
+	switch {
+	case name != "":
+		asset, err := findPermissionAsset(assets, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, *asset)
+
+	case options.AllInProject != "":
+		selected, err = r.projectPermissionAssets(assets, kind, options.AllInProject)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		for _, ele := range assets {
+			matched, err := path.Match(options.Selector, ele.Name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector `%s`: %s", options.Selector, err)
+			}
+			if matched {
+				selected = append(selected, ele)
+			}
+		}
+	}
+
+	if len(selected) == 0 {
+		return &Response{
+			Text: fmt.Sprintf("No %s assets matched, no changes were made", kind),
+		}, nil
+	}
+
+	groups, err := resources.NewGroupResource(services.NewGroupService(r.client)).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	read, err := permissionGroupIds(options.Read, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	write, err := permissionGroupIds(options.Write, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new permissions for every selected asset are validated before any
+	// asset is updated so an invalid asset never leaves the set half changed.
+	updates := make([]services.Gbac, len(selected))
+
+	for idx, ele := range selected {
+		gbac := ele.Gbac
+		if read != nil {
+			gbac.Read = read
+		}
+		if write != nil {
+			gbac.Write = write
+		}
+
+		if err := resources.ValidateGbacRules(toInterfaceSlice(gbac.Read), toInterfaceSlice(gbac.Write)); err != nil {
+			return nil, fmt.Errorf("%s `%s`: %s", ele.Kind, ele.Name, err)
+		}
+
+		updates[idx] = gbac
+	}
+
+	var output []string
+
+	for idx, ele := range selected {
+		if err := ele.update(updates[idx]); err != nil {
+			return nil, fmt.Errorf("failed to update permissions for %s `%s`: %s", ele.Kind, ele.Name, err)
+		}
+
+		output = append(output, fmt.Sprintf("Successfully updated permissions for %s `%s`", ele.Kind, ele.Name))
+	}
+
+	return &Response{Text: strings.Join(output, "\n")}, nil
+}
+
+// permissionAssets returns every asset of the kind on the server.
+func (r *PermissionRunner) permissionAssets(kind string) ([]permissionAsset, error) {
+	logging.Trace()
+
+	switch kind {
+	case dependencyWorkflow, dependencyTemplate, dependencyTransformation, dependencyJsonForm:
+	default:
+		return nil, fmt.Errorf(
+			"unsupported asset type `%s`, must be one of `workflow`, `template`, `transformation` or `jsonform`",
+			kind,
+		)
+	}
+
+	svc := services.NewPermissionService(r.client)
+
+	res, err := svc.GetAll(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []permissionAsset
+
+	for _, ele := range res {
+		assets = append(assets, permissionAsset{
+			Kind: kind, Name: ele.Name, Id: ele.Id, Gbac: ele.Gbac,
+			update: func(gbac services.Gbac) error {
+				ele.Gbac = gbac
+				return svc.Update(kind, ele)
+			},
+		})
+	}
+
+	return assets, nil
+}
+
+// projectPermissionAssets returns the assets that are components of the
+// named project.
+func (r *PermissionRunner) projectPermissionAssets(assets []permissionAsset, kind, name string) ([]permissionAsset, error) {
+	logging.Trace()
+
+	project, err := resources.NewProjectResource(services.NewProjectService(r.client)).GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, ele := range project.Components {
+		if strings.EqualFold(ele.Type, kind) {
+			ids[ele.Reference] = true
+		}
+	}
+
+	var res []permissionAsset
+	for _, ele := range assets {
+		if ids[ele.Id] {
+			res = append(res, ele)
+		}
+	}
+
+	return res, nil
+}
+
+// groupNames returns a map of group ids to group names.
+func (r *PermissionRunner) groupNames() (map[string]string, error) {
+	logging.Trace()
+
+	groups, err := resources.NewGroupResource(services.NewGroupService(r.client)).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]string{}
+	for _, ele := range groups {
+		res[ele.Id] = ele.Name
+	}
+
+	return res, nil
+}
+
+// permissionGroupIds validates the group names and returns the ids of the
+// groups.  A nil slice is returned when the names are nil so callers can
+// tell an option that was not specified from one that clears the groups.
+// Active groups are preferred when more than one group has the same name.
+func permissionGroupIds(names []string, groups []services.Group) ([]string, error) {
+	if names == nil {
+		return nil, nil
+	}
+
+	byName := map[string]services.Group{}
+	for _, ele := range groups {
+		if current, exists := byName[ele.Name]; !exists || (current.Inactive && !ele.Inactive) {
+			byName[ele.Name] = ele
+		}
+	}
+
+	res := []string{}
+	for _, ele := range names {
+		ele = strings.TrimSpace(ele)
+		if ele == "" {
+			continue
+		}
+		group, exists := byName[ele]
+		if !exists {
+			return nil, fmt.Errorf("group `%s` does not exist", ele)
+		}
+		res = append(res, group.Id)
+	}
+
+	return res, nil
+}
+
+func findPermissionAsset(assets []permissionAsset, kind, name string) (*permissionAsset, error) {
+	for idx := range assets {
+		if assets[idx].Name == name {
+			return &assets[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("%s `%s` not found", kind, name)
+}
+
+// permissionGroupNames replaces the group ids with group names.  Ids that do
+// not match a group are returned unchanged.
+func permissionGroupNames(ids []string, groups map[string]string) []string {
+	res := []string{}
+	for _, ele := range ids {
+		if name, exists := groups[ele]; exists {
+			res = append(res, name)
+		} else {
+			res = append(res, ele)
+		}
+	}
+	return res
+}
+
+func permissionGroupList(groups []string) string {
+	if len(groups) == 0 {
+		return "-"
+	}
+	return strings.Join(groups, ", ")
+}
+
+func toInterfaceSlice(in []string) []interface{} {
+	res := make([]interface{}, len(in))
+	for idx, ele := range in {
+		res[idx] = ele
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPermissionsMux(t *testing.T) (*PermissionRunner, *renameTestServer) {
+	runner := NewPermissionRunner(testlib.Setup(), testlib.DefaultConfig())
+	server := &renameTestServer{}
+
+	var workflows []map[string]interface{}
+
+	for _, ele := range []services.Workflow{
+		renameTestWorkflow("w1", "ip-assign", ""),
+		renameTestWorkflow("w2", "ip-release", ""),
+		renameTestWorkflow("w3", "backup", ""),
+	} {
+		document, err := toMap(ele)
+		require.NoError(t, err)
+		workflows = append(workflows, document)
+	}
+
+	// gbac and fields not modelled by services.Workflow are only available
+	// in the raw document returned by the server
+	workflows[0]["gbac"] = map[string]interface{}{"read": []string{"g1"}, "write": []string{"g2"}}
+	workflows[0]["customField"] = "kept"
+
+	b, err := json.Marshal(map[string]interface{}{"items": workflows, "total": len(workflows)})
+	require.NoError(t, err)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", string(b), 0)
+
+	testlib.AddGetResponseToMux("/authorization/groups", `{
+		"results": [
+			{"_id": "g1", "name": "netops"},
+			{"_id": "g2", "name": "admins"},
+			{"_id": "g3", "name": "admins", "inactive": true}
+		],
+		"total": 3
+	}`, 0)
+
+	testlib.AddHandlerToMux("PUT /automation-studio/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body := server.record(dependencyWorkflow, r)
+		json.NewEncoder(w).Encode(body["update"])
+	})
+
+	return runner, server
+}
+
+func TestPermissionsGet(t *testing.T) {
+	runner, _ := setupPermissionsMux(t)
+	defer testlib.Teardown()
+
+	res, err := runner.Get(Request{Args: []string{"workflow", "ip-assign"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Read:  netops\nWrite: admins", res.Text)
+
+	res, err = runner.Get(Request{Args: []string{"workflow", "backup"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Read:  -\nWrite: -", res.Text)
+
+	_, err = runner.Get(Request{Args: []string{"workflow", "missing"}})
+	assert.EqualError(t, err, "workflow `missing` not found")
+
+	_, err = runner.Get(Request{Args: []string{"automation", "ip-assign"}})
+	assert.ErrorContains(t, err, "unsupported asset type `automation`")
+}
+
+func TestPermissionsSetSelector(t *testing.T) {
+	runner, server := setupPermissionsMux(t)
+	defer testlib.Teardown()
+
+	res, err := runner.Set(Request{
+		Args: []string{"workflow"},
+		Options: &flags.PermissionSetOptions{
+			Write:    []string{"admins", "netops"},
+			Selector: "ip-*",
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "Successfully updated permissions for workflow `ip-assign`")
+	assert.Contains(t, res.Text, "Successfully updated permissions for workflow `ip-release`")
+
+	assert.Equal(t, []string{"workflow/w1", "workflow/w2"}, server.updates)
+
+	// the read groups of the first workflow are kept and the inactive admins
+	// group is never selected
+	update := server.bodies[0]["update"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"read":  []interface{}{"g1"},
+		"write": []interface{}{"g2", "g1"},
+	}, update["gbac"])
+	assert.Equal(t, "kept", update["customField"])
+}
+
+func TestPermissionsSetValidation(t *testing.T) {
+	runner, server := setupPermissionsMux(t)
+	defer testlib.Teardown()
+
+	_, err := runner.Set(Request{
+		Args:    []string{"workflow", "backup"},
+		Options: &flags.PermissionSetOptions{Read: []string{"unknown"}},
+	})
+	assert.EqualError(t, err, "group `unknown` does not exist")
+
+	_, err = runner.Set(Request{
+		Args:    []string{"workflow", "backup"},
+		Options: &flags.PermissionSetOptions{Read: []string{"netops"}},
+	})
+	assert.EqualError(t, err, "workflow `backup`: write group must be configured, when read group present")
+
+	_, err = runner.Set(Request{
+		Args:    []string{"workflow", "backup"},
+		Options: &flags.PermissionSetOptions{Write: []string{"admins"}, Selector: "*"},
+	})
+	assert.EqualError(t, err, "specify exactly one of an asset name, `--all-in-project` or `--selector`")
+
+	_, err = runner.Set(Request{
+		Args:    []string{"workflow", "backup"},
+		Options: &flags.PermissionSetOptions{},
+	})
+	assert.EqualError(t, err, "at least one of `--read` or `--write` must be specified")
+
+	assert.Empty(t, server.updates)
+}
+
+func TestPermissionsSetSelectorValidatesAll(t *testing.T) {
+	runner, server := setupPermissionsMux(t)
+	defer testlib.Teardown()
+
+	// ip-assign already has write groups but ip-release does not, so no
+	// workflow is updated
+	_, err := runner.Set(Request{
+		Args: []string{"workflow"},
+		Options: &flags.PermissionSetOptions{
+			Read:     []string{"netops"},
+			Selector: "ip-*",
+		},
+	})
+	assert.EqualError(t, err, "workflow `ip-release`: write group must be configured, when read group present")
+
+	assert.Empty(t, server.updates)
+}
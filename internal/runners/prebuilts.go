@@ -374,7 +374,7 @@ func (r *PrebuiltRunner) Export(in Request) (*Response, error) {
 	} else {
 		fn := fmt.Sprintf("%s.prebuilt.json", strings.Replace(pkg.Metadata.Name, "/", "_", 1))
 
-		if exportAssetFromRequest(in, pkg, fn); err != nil {
+		if exportAssetFromRequest(in, "prebuilt", pkg, fn); err != nil {
 			return nil, err
 		}
 	}
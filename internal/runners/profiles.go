@@ -249,7 +249,7 @@ func (r *ProfileRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.profile.json", name)
 
-	if err := exportAssetFromRequest(in, profile, fn); err != nil {
+	if err := exportAssetFromRequest(in, "profile", profile, fn); err != nil {
 		return nil, err
 	}
 
@@ -279,7 +279,7 @@ func (r *ProfileRunner) Dump(in Request) (*Response, error) {
 		assets[key] = ele
 	}
 
-	if err := dumpAssets(in, assets); err != nil {
+	if err := dumpAssets(in, "profile", assets); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,343 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// projectComponentTypes maps the asset kinds that can be converted into
+// project components to the component type used by the project API.
+var projectComponentTypes = map[string]string{
+	dependencyWorkflow:       "workflow",
+	dependencyTransformation: "transformation",
+	dependencyJsonForm:       "jsonForm",
+	dependencyTemplate:       "template",
+}
+
+// projectAssetName returns the name of an asset once it has been moved into
+// the project.  Automation Studio scopes the names of project assets by
+// prefixing them with the project id.
+func projectAssetName(projectId, name string) string {
+	return fmt.Sprintf("@%s: %s", projectId, name)
+}
+
+// createFromAssets implements `create project <name> --from-assets ...`.  The
+// selected global assets, and optionally their dependencies, are added to a
+// new project bundle.  Assets in the bundle are renamed to project scoped
+// names and references by name between assets in the bundle are rewritten so
+// the project copies reference each other instead of the global assets.
+// Every component is given a new id and references by id to transformations
+// and JSON forms are changed to match.  The bundle is imported through the
+// project service and the original assets are kept unless --delete-originals
+// is specified.
+func (r *ProjectRunner) createFromAssets(name string, options flags.ProjectCreateOptions) (*Response, error) {
+	logging.Trace()
+
+	resolver := newDependencyResolver(r.client)
+
+	root := &assetDependency{}
+
+	for _, ele := range options.FromAssets {
+		node, err := r.resolveProjectAsset(resolver, ele)
+		if err != nil {
+			return nil, err
+		}
+		root.Dependencies = append(root.Dependencies, node)
+	}
+
+	var nodes []*assetDependency
+
+	if options.IncludeDependencies {
+		if err := dependencyUnresolved(root); err != nil {
+			return nil, err
+		}
+		for _, ele := range dependencyOrder(root) {
+			if ele == root {
+				continue
+			}
+			if _, exists := projectComponentTypes[ele.Kind]; !exists {
+				logging.Info("%s `%s` can not be added to a project and is left unchanged", ele.Kind, ele.Name)
+				continue
+			}
+			nodes = append(nodes, ele)
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, ele := range root.Dependencies {
+			if !seen[ele.key()] {
+				seen[ele.key()] = true
+				nodes = append(nodes, ele)
+			}
+		}
+	}
+
+	projectId, err := newObjectId()
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := buildProjectFromAssets(projectId, name, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Component names and the references between components are built
+	// from the project id so the bundle is imported with the references it
+	// already has.  If the server assigns a different id the names no
+	// longer match the project, so it is deleted and the originals are left
+	// in place.
+	imported, err := r.resource.Import(*project, services.ProjectImportConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	if imported.Id != projectId {
+		// Cleanup: delete the project since its assets reference ids that
+		// do not belong to it
+		if delErr := r.resource.Delete(imported.Id); delErr != nil {
+			logging.Error(delErr, "failed to cleanup project %s after id mismatch", imported.Id)
+		}
+		return nil, fmt.Errorf(
+			"project `%s` was imported with id `%s` instead of `%s`, the references between its assets are invalid",
+			imported.Name, imported.Id, projectId,
+		)
+	}
+
+	output := []string{
+		fmt.Sprintf("Successfully created project `%s` (%s) from %v asset(s)", imported.Name, imported.Id, len(nodes)),
+	}
+
+	for _, ele := range nodes {
+		output = append(output, fmt.Sprintf("  %s `%s`", ele.Kind, ele.Name))
+	}
+
+	if options.DeleteOriginals {
+		// Assets are deleted in the reverse of the dependency order so an
+		// asset is never deleted while another original still uses it.
+		for idx := len(nodes) - 1; idx >= 0; idx-- {
+			node := nodes[idx]
+			if err := r.deleteProjectAsset(node); err != nil {
+				return nil, fmt.Errorf(
+					"project `%s` was created but the original %s `%s` could not be deleted: %s",
+					imported.Name, node.Kind, node.Name, err,
+				)
+			}
+			output = append(output, fmt.Sprintf("Deleted original %s `%s`", node.Kind, node.Name))
+		}
+	}
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: imported,
+	}, nil
+}
+
+// resolveProjectAsset parses an asset specification in the form
+// <kind>:<name> and resolves the asset along with its dependencies.
+func (r *ProjectRunner) resolveProjectAsset(resolver *dependencyResolver, spec string) (*assetDependency, error) {
+	logging.Trace()
+
+	kind, name, found := strings.Cut(strings.TrimSpace(spec), ":")
+	if !found || name == "" {
+		return nil, fmt.Errorf("invalid asset %q (must be <kind>:<name>)", spec)
+	}
+
+	kind = strings.ToLower(kind)
+
+	if _, exists := projectComponentTypes[kind]; !exists {
+		return nil, fmt.Errorf(
+			"unsupported asset type `%s`, must be one of `workflow`, `transformation`, `jsonform` or `template`",
+			kind,
+		)
+	}
+
+	// The resolver looks up transformations and JSON forms by id because
+	// that is how workflows reference them.
+	value := name
+
+	switch kind {
+	case dependencyTransformation:
+		res, err := resources.NewTransformationResource(services.NewTransformationService(r.client)).GetByName(name)
+		if err != nil {
+			return nil, err
+		}
+		value = res.Id
+	case dependencyJsonForm:
+		res, err := resources.NewJsonFormResource(services.NewJsonFormService(r.client)).GetByName(name)
+		if err != nil {
+			return nil, err
+		}
+		value = res.Id
+	}
+
+	return resolver.Resolve(kind, value)
+}
+
+// deleteProjectAsset deletes the original global asset after it has been
+// copied into a project.
+func (r *ProjectRunner) deleteProjectAsset(node *assetDependency) error {
+	logging.Trace()
+
+	switch asset := node.Asset.(type) {
+	case services.Workflow:
+		return services.NewWorkflowService(r.client).Delete(asset.Name)
+	case services.Transformation:
+		return services.NewTransformationService(r.client).Delete(asset.Id)
+	case services.JsonForm:
+		return services.NewJsonFormService(r.client).Delete([]string{asset.Id})
+	case services.Template:
+		return services.NewTemplateService(r.client).Delete(asset.Id)
+	}
+
+	return fmt.Errorf("unsupported asset type `%s`", node.Kind)
+}
+
+// buildProjectFromAssets returns a project bundle with a component for each
+// asset.  Components are added to the root folder of the project in the
+// order the nodes are listed.
+func buildProjectFromAssets(projectId, name string, nodes []*assetDependency) (*services.Project, error) {
+	logging.Trace()
+
+	// Workflows and templates are referenced by name so every reference to
+	// an asset in the bundle is changed to the project scoped name
+	renames := map[string]map[string]string{
+		dependencyWorkflow: {},
+		dependencyTemplate: {},
+	}
+
+	// Every component gets a new reference so the copies do not collide
+	// with the original assets.  Transformations and JSON forms are
+	// referenced by id so those references are changed to the new ids.
+	references := make([]string, len(nodes))
+	ids := map[string]map[string]string{
+		dependencyTransformation: {},
+		dependencyJsonForm:       {},
+	}
+
+	for idx, ele := range nodes {
+		if _, exists := renames[ele.Kind]; exists {
+			renames[ele.Kind][ele.Name] = projectAssetName(projectId, ele.Name)
+		}
+
+		reference, err := newObjectId()
+		if err != nil {
+			return nil, err
+		}
+		references[idx] = reference
+
+		switch v := ele.Asset.(type) {
+		case services.Transformation:
+			ids[dependencyTransformation][v.Id] = reference
+		case services.JsonForm:
+			ids[dependencyJsonForm][v.Id] = reference
+		}
+	}
+
+	project := &services.Project{
+		Id:         projectId,
+		Name:       name,
+		Components: []services.ProjectComponent{},
+		Folders:    []services.ProjectFolder{},
+	}
+
+	for idx, ele := range nodes {
+		iid := idx + 1
+
+		asset := ele.Asset
+		reference := references[idx]
+		idField := "_id"
+
+		switch v := asset.(type) {
+		case services.Workflow:
+			wf, err := cloneWorkflow(v)
+			if err != nil {
+				return nil, err
+			}
+			for _, kind := range []string{dependencyWorkflow, dependencyTemplate} {
+				for from, to := range renames[kind] {
+					renameTaskReferences(&wf, kind, from, to)
+				}
+			}
+			replaceTaskIds(&wf, ids)
+			asset = wf
+		case services.Transformation, services.Template:
+		case services.JsonForm:
+			idField = "id"
+		default:
+			return nil, fmt.Errorf("unsupported asset type `%s`", ele.Kind)
+		}
+
+		document, err := toMap(asset)
+		if err != nil {
+			return nil, err
+		}
+		document["name"] = projectAssetName(projectId, ele.Name)
+		document[idField] = reference
+
+		project.Components = append(project.Components, services.ProjectComponent{
+			Iid:       iid,
+			Type:      projectComponentTypes[ele.Kind],
+			Folder:    "/",
+			Reference: reference,
+			Document:  document,
+		})
+
+		project.Folders = append(project.Folders, services.ProjectFolder{
+			Iid:      iid,
+			NodeType: "component",
+		})
+	}
+
+	return project, nil
+}
+
+// replaceTaskIds changes the transformation and JSON form ids used by the
+// tasks of the workflow to the ids in the ids map, keyed by kind and then by
+// the original id.
+func replaceTaskIds(wf *services.Workflow, ids map[string]map[string]string) {
+	fields := map[string]string{
+		dependencyTransformation: "tr_id",
+		dependencyJsonForm:       "formId",
+	}
+
+	for _, key := range workflowTaskKeys(*wf) {
+		incoming := workflowTaskIncoming(workflowTaskMap(*wf, key))
+		if incoming == nil {
+			continue
+		}
+		for kind, field := range fields {
+			if value, ok := incoming[field].(string); ok {
+				if id, exists := ids[kind][value]; exists {
+					incoming[field] = id
+				}
+			}
+		}
+	}
+}
+
+// newObjectId returns a new id in the format of a MongoDB ObjectId.  The
+// first four bytes are the current time followed by eight random bytes.
+func newObjectId() (string, error) {
+	b := make([]byte, 12)
+
+	binary.BigEndian.PutUint32(b, uint32(time.Now().Unix()))
+
+	if _, err := rand.Read(b[4:]); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectConvertTestServer records the project bundle sent to the import
+// endpoint and the original assets deleted afterwards.
+type projectConvertTestServer struct {
+	sync.Mutex
+	project map[string]interface{}
+	deleted []string
+
+	// projectId overrides the id of the imported project returned by the
+	// server, by default the id in the bundle is returned
+	projectId interface{}
+}
+
+func setupProjectConvertMux(t *testing.T) *projectConvertTestServer {
+	server := &projectConvertTestServer{}
+
+	setupDependencyMux(t)
+
+	testlib.AddGetResponseToMux("/automation-studio/projects", `{"data": [], "metadata": {"total": 0}}`, 0)
+
+	testlib.AddHandlerToMux("POST /automation-studio/projects/import", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Project map[string]interface{} `json:"project"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(b, &body))
+
+		server.Lock()
+		server.project = body.Project
+		server.Unlock()
+
+		server.Lock()
+		id := server.projectId
+		server.Unlock()
+		if id == nil {
+			id = body.Project["_id"]
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Successfully imported project",
+			"data":    map[string]interface{}{"_id": id, "name": body.Project["name"]},
+		})
+	})
+
+	testlib.AddHandlerToMux("DELETE /automation-studio/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		server.deleted = append(server.deleted, "project/"+r.PathValue("id"))
+		w.Write([]byte(`{}`))
+	})
+
+	testlib.AddHandlerToMux("DELETE /workflow_builder/workflows/delete/{name}", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		server.deleted = append(server.deleted, "workflow/"+r.PathValue("name"))
+		w.Write([]byte(`{}`))
+	})
+
+	return server
+}
+
+// projectConvertComponents returns the components of the imported bundle
+// keyed by component type and name.
+func projectConvertComponents(project map[string]interface{}) map[string]map[string]interface{} {
+	res := map[string]map[string]interface{}{}
+	for _, ele := range project["components"].([]interface{}) {
+		component := ele.(map[string]interface{})
+		document := component["document"].(map[string]interface{})
+		res[component["type"].(string)+"/"+document["name"].(string)] = component
+	}
+	return res
+}
+
+func projectConvertChildWorkflow(component map[string]interface{}) interface{} {
+	tasks := component["document"].(map[string]interface{})["tasks"].(map[string]interface{})
+	return tasks["c1"].(map[string]interface{})["variables"].(map[string]interface{})["incoming"].(map[string]interface{})["workflow"]
+}
+
+func TestProjectCreateFromAssets(t *testing.T) {
+	runner := NewProjectRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	server := setupProjectConvertMux(t)
+
+	res, err := runner.Create(Request{
+		Args: []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{
+			FromAssets:          []string{"workflow:parent"},
+			IncludeDependencies: true,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, server.project)
+
+	pid := server.project["_id"].(string)
+	assert.Len(t, pid, 24)
+
+	assert.Contains(t, res.Text, "Successfully created project `Provisioning` ("+pid+") from 3 asset(s)")
+	assert.Empty(t, server.deleted)
+	assert.Equal(t, "Provisioning", server.project["name"])
+
+	components := projectConvertComponents(server.project)
+	require.Len(t, components, 3)
+
+	parent := components["workflow/"+projectAssetName(pid, "parent")]
+	child := components["workflow/"+projectAssetName(pid, "child")]
+	jst := components["transformation/"+projectAssetName(pid, "My JST")]
+
+	require.NotNil(t, parent)
+	require.NotNil(t, child)
+	require.NotNil(t, jst)
+
+	// the copies get new ids and the workflows use the new transformation id
+	reference := jst["reference"].(string)
+	assert.Len(t, reference, 24)
+	assert.NotEqual(t, "jst1", reference)
+	assert.Equal(t, reference, jst["document"].(map[string]interface{})["_id"])
+	assert.NotEqual(t, reference, parent["reference"])
+	assert.Equal(t, "/", parent["folder"])
+
+	tasks := parent["document"].(map[string]interface{})["tasks"].(map[string]interface{})
+	var trIds []interface{}
+	for _, ele := range tasks {
+		task := ele.(map[string]interface{})
+		if variables, ok := task["variables"].(map[string]interface{}); ok {
+			if incoming, ok := variables["incoming"].(map[string]interface{}); ok && incoming["tr_id"] != nil {
+				trIds = append(trIds, incoming["tr_id"])
+			}
+		}
+	}
+	assert.Equal(t, []interface{}{reference}, trIds)
+
+	// references between the workflows point to the project copies
+	assert.Equal(t, projectAssetName(pid, "child"), projectConvertChildWorkflow(parent))
+	assert.Equal(t, projectAssetName(pid, "parent"), projectConvertChildWorkflow(child))
+
+	folders := server.project["folders"].([]interface{})
+	require.Len(t, folders, 3)
+	assert.Equal(t, map[string]interface{}{"nodeType": "component", "iid": float64(1)}, folders[0])
+}
+
+func TestProjectCreateFromAssetsWithoutDependencies(t *testing.T) {
+	runner := NewProjectRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	server := setupProjectConvertMux(t)
+
+	_, err := runner.Create(Request{
+		Args: []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{
+			FromAssets:      []string{"workflow:parent"},
+			DeleteOriginals: true,
+		},
+	})
+	require.NoError(t, err)
+
+	pid := server.project["_id"].(string)
+
+	components := projectConvertComponents(server.project)
+	require.Len(t, components, 1)
+
+	// the child workflow stays global so the reference is left unchanged
+	parent := components["workflow/"+projectAssetName(pid, "parent")]
+	require.NotNil(t, parent)
+	assert.Equal(t, "child", projectConvertChildWorkflow(parent))
+
+	assert.Equal(t, []string{"workflow/parent"}, server.deleted)
+}
+
+func TestProjectCreateFromAssetsIdMismatch(t *testing.T) {
+	runner := NewProjectRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	server := setupProjectConvertMux(t)
+	server.projectId = "p1"
+
+	_, err := runner.Create(Request{
+		Args: []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{
+			FromAssets:      []string{"workflow:parent"},
+			DeleteOriginals: true,
+		},
+	})
+	require.NotNil(t, server.project)
+
+	pid := server.project["_id"].(string)
+	assert.EqualError(t, err, "project `Provisioning` was imported with id `p1` instead of `"+pid+"`, the references between its assets are invalid")
+
+	// the project with the invalid references is removed and the originals
+	// are kept
+	assert.Equal(t, []string{"project/p1"}, server.deleted)
+}
+
+func TestProjectCreateFromAssetsInvalid(t *testing.T) {
+	runner := NewProjectRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	setupProjectConvertMux(t)
+
+	_, err := runner.Create(Request{
+		Args:    []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{FromAssets: []string{"parent"}},
+	})
+	assert.EqualError(t, err, `invalid asset "parent" (must be <kind>:<name>)`)
+
+	_, err = runner.Create(Request{
+		Args:    []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{FromAssets: []string{"automation:parent"}},
+	})
+	assert.ErrorContains(t, err, "unsupported asset type `automation`")
+
+	_, err = runner.Create(Request{
+		Args:    []string{"Provisioning"},
+		Options: &flags.ProjectCreateOptions{DeleteOriginals: true},
+	})
+	assert.EqualError(t, err, "--include-dependencies and --delete-originals require --from-assets")
+}
@@ -0,0 +1,340 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// ProjectMemberRunner implements the commands that manage the members of an
+// existing project.  Members are parsed and resolved the same way as the
+// `--member` option of `import project`.
+type ProjectMemberRunner struct {
+	BaseRunner
+	projects *ProjectRunner
+}
+
+func NewProjectMemberRunner(c client.Client, cfg config.Provider) *ProjectMemberRunner {
+	return &ProjectMemberRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+		projects:   NewProjectRunner(c, cfg),
+	}
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Reader Interface
+//
+
+// Get implements the `get project-members <project>` command
+func (r *ProjectMemberRunner) Get(in Request) (*Response, error) {
+	logging.Trace()
+
+	project, err := r.getProject(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	members := []map[string]interface{}{}
+
+	for _, ele := range project.Members {
+		members = append(members, map[string]interface{}{
+			"type": ele.Type,
+			"name": projectMemberName(ele),
+			"role": ele.Role,
+		})
+	}
+
+	return &Response{
+		Keys:   []string{"type", "name", "role"},
+		Object: members,
+	}, nil
+}
+
+// Describe is not supported for project members
+func (r *ProjectMemberRunner) Describe(in Request) (*Response, error) {
+	return notImplemented(in)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Adder Interface
+//
+
+// Add implements the `add project-member <project> <member>...` command.
+// Members that are already in the project are updated when the role is
+// different.  Members with the same role and the active user are skipped
+// and reported.
+func (r *ProjectMemberRunner) Add(in Request) (*Response, error) {
+	logging.Trace()
+
+	if len(in.Args) < 2 {
+		return nil, errors.New("requires a project and at least one member")
+	}
+
+	project, err := r.getProject(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	activeUser, err := r.projects.userSettings.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active user: %w", err)
+	}
+
+	current := map[string]services.ProjectMember{}
+	for _, ele := range project.Members {
+		current[ele.Reference] = ele
+	}
+
+	var members []services.ProjectMember
+	var added, updated int
+	var skipped []string
+
+	seen := map[string]bool{}
+
+	for _, spec := range in.Args[1:] {
+		member, err := parseMember(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member specification %q: %w", spec, err)
+		}
+
+		if member.Type == services.MemberTypeAccount && member.Name == activeUser.Username {
+			skipped = append(skipped, fmt.Sprintf("Skipped %s `%s`, the active user is not changed", member.Type, member.Name))
+			continue
+		}
+
+		resolved, err := r.projects.resolveMember(member, r.projects.accounts, r.projects.groups)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve member %q: %w", member.Name, err)
+		}
+
+		if seen[resolved.Reference] {
+			skipped = append(skipped, fmt.Sprintf("Skipped %s `%s`, specified more than once", member.Type, member.Name))
+			continue
+		}
+		seen[resolved.Reference] = true
+
+		if existing, exists := current[resolved.Reference]; exists {
+			if existing.Role == resolved.Role {
+				skipped = append(skipped, fmt.Sprintf(
+					"Skipped %s `%s`, already a member with role `%s`", member.Type, member.Name, existing.Role,
+				))
+				continue
+			}
+			updated++
+		} else {
+			added++
+		}
+
+		members = append(members, resolved)
+	}
+
+	if len(members) > 0 {
+		if err := r.projects.resource.AddMembers(project.Id, members); err != nil {
+			return nil, fmt.Errorf("failed to add members to project: %w", err)
+		}
+	}
+
+	output := []string{
+		fmt.Sprintf("Added %v and updated %v member(s) of project `%s`", added, updated, project.Name),
+	}
+	output = append(output, skipped...)
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: members,
+	}, nil
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Remover Interface
+//
+
+// Remove implements the `remove project-member <project> <member>...`
+// command
+func (r *ProjectMemberRunner) Remove(in Request) (*Response, error) {
+	logging.Trace()
+
+	if len(in.Args) < 2 {
+		return nil, errors.New("requires a project and at least one member")
+	}
+
+	project, err := r.getProject(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	remove := map[string]bool{}
+	var missing []string
+
+	for _, spec := range in.Args[1:] {
+		member, err := parseMember(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member specification %q: %w", spec, err)
+		}
+
+		resolved, err := r.projects.resolveMember(member, r.projects.accounts, r.projects.groups)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve member %q: %w", member.Name, err)
+		}
+
+		if !projectHasMember(project.Members, resolved.Reference) {
+			missing = append(missing, member.Name)
+			continue
+		}
+
+		remove[resolved.Reference] = true
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf(
+			"member(s) not a member of project `%s`: %s",
+			project.Name, strings.Join(missing, ", "),
+		)
+	}
+
+	var members []services.ProjectMember
+	for _, ele := range project.Members {
+		if !remove[ele.Reference] {
+			members = append(members, ele)
+		}
+	}
+
+	if err := r.projects.resource.UpdateMembers(project.Id, members); err != nil {
+		return nil, fmt.Errorf("failed to update project members: %w", err)
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Removed %v member(s) from project `%s`", len(remove), project.Name),
+	}, nil
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Setter Interface
+//
+
+// Set implements the `set project-members <project> --from-file <path>`
+// command.  The members of the project are replaced with the members listed
+// in the file.  The active user is always kept so the command can not remove
+// the account that runs it from the project.
+func (r *ProjectMemberRunner) Set(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.ProjectMemberSetOptions)
+
+	specs, err := readProjectMembersFile(options.FromFile)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := r.getProject(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	activeUser, err := r.projects.userSettings.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active user: %w", err)
+	}
+
+	members, err := r.projects.buildProjectMembers(specs, activeUser.Username, r.projects.accounts, r.projects.groups)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ele := range project.Members {
+		if ele.Type == services.MemberTypeAccount && ele.Username == activeUser.Username {
+			members = append(members, ele)
+		}
+	}
+
+	if err := r.projects.resource.UpdateMembers(project.Id, members); err != nil {
+		return nil, fmt.Errorf("failed to update project members: %w", err)
+	}
+
+	return &Response{
+		Text:   fmt.Sprintf("Successfully set %v member(s) on project `%s`", len(members), project.Name),
+		Object: members,
+	}, nil
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Private functions
+//
+
+// getProject returns the named project including its members.
+func (r *ProjectMemberRunner) getProject(name string) (*services.Project, error) {
+	logging.Trace()
+
+	project, err := r.projects.resource.GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.projects.resource.Get(project.Id)
+}
+
+// readProjectMembersFile loads the members file and returns each member as a
+// member specification string.  Entries are either specification strings or
+// objects with `type`, `name` and `access` keys.
+func readProjectMembersFile(path string) ([]string, error) {
+	logging.Trace()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []interface{}
+	if err := utils.UnmarshalData(b, &entries); err != nil {
+		return nil, fmt.Errorf("failed to load members from `%s`: %w", path, err)
+	}
+
+	var specs []string
+
+	for idx, ele := range entries {
+		switch v := utils.NormalizeValue(ele).(type) {
+		case string:
+			specs = append(specs, v)
+		case map[string]interface{}:
+			spec := fmt.Sprintf("type=%v,name=%v", v["type"], v["name"])
+			if access, exists := v["access"]; exists {
+				spec += fmt.Sprintf(",access=%v", access)
+			}
+			specs = append(specs, spec)
+		default:
+			return nil, fmt.Errorf("invalid member at index %v in `%s`", idx, path)
+		}
+	}
+
+	return specs, nil
+}
+
+func projectHasMember(members []services.ProjectMember, reference string) bool {
+	for _, ele := range members {
+		if ele.Reference == reference {
+			return true
+		}
+	}
+	return false
+}
+
+// projectMemberName returns the username of an account member or the name of
+// a group member.
+func projectMemberName(member services.ProjectMember) string {
+	if member.Username != "" {
+		return member.Username
+	}
+	return member.Name
+}
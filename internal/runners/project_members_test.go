@@ -0,0 +1,170 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupProjectMembersMux registers a project with the active user `admin`
+// and the account `alice` as members.  The members sent to the server are
+// returned in the updates slice.
+func setupProjectMembersMux(t *testing.T) (*ProjectMemberRunner, *[][]services.ProjectMember) {
+	runner := NewProjectMemberRunner(testlib.Setup(), testlib.DefaultConfig())
+
+	testlib.AddGetResponseToMux("/automation-studio/projects", `{
+		"data": [{"_id": "p1", "name": "Provisioning"}],
+		"metadata": {"total": 1}
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/automation-studio/projects/p1", `{
+		"data": {
+			"_id": "p1",
+			"name": "Provisioning",
+			"members": [
+				{"type": "account", "reference": "a0", "username": "admin", "role": "owner", "provenance": "local"},
+				{"type": "account", "reference": "a1", "username": "alice", "role": "editor", "provenance": "local"}
+			]
+		},
+		"metadata": {}
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/authorization/accounts", `{
+		"results": [
+			{"_id": "a0", "username": "admin", "provenance": "local"},
+			{"_id": "a1", "username": "alice", "provenance": "local"},
+			{"_id": "a2", "username": "bob", "provenance": "local"}
+		],
+		"total": 3
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/authorization/groups", `{
+		"results": [{"_id": "g1", "name": "netops", "provenance": "local"}],
+		"total": 1
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/user/settings", `{"username": "admin"}`, 0)
+
+	updates := &[][]services.ProjectMember{}
+
+	testlib.AddHandlerToMux("PATCH /automation-studio/projects/p1", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Members []services.ProjectMember `json:"members"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		*updates = append(*updates, body.Members)
+		w.Write([]byte(`{}`))
+	})
+
+	return runner, updates
+}
+
+func projectMemberReferences(members []services.ProjectMember) map[string]string {
+	res := map[string]string{}
+	for _, ele := range members {
+		res[ele.Reference] = ele.Role
+	}
+	return res
+}
+
+func TestParseMemberShortForm(t *testing.T) {
+	m, err := parseMember("account:alice:owner")
+	require.NoError(t, err)
+	assert.Equal(t, &Member{Type: "account", Name: "alice", Access: "owner"}, m)
+
+	m, err = parseMember("group:netops")
+	require.NoError(t, err)
+	assert.Equal(t, &Member{Type: "group", Name: "netops", Access: "editor"}, m)
+
+	_, err = parseMember("alice")
+	assert.ErrorContains(t, err, "must be <type>:<name>[:<access>]")
+
+	_, err = parseMember("group:netops:admin")
+	assert.ErrorContains(t, err, `invalid access "admin"`)
+}
+
+func TestProjectMembersGet(t *testing.T) {
+	runner, _ := setupProjectMembersMux(t)
+	defer testlib.Teardown()
+
+	res, err := runner.Get(Request{Args: []string{"Provisioning"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"type": "account", "name": "admin", "role": "owner"},
+		{"type": "account", "name": "alice", "role": "editor"},
+	}, res.Object)
+}
+
+func TestProjectMembersAdd(t *testing.T) {
+	runner, updates := setupProjectMembersMux(t)
+	defer testlib.Teardown()
+
+	res, err := runner.Add(Request{Args: []string{"Provisioning", "account:alice:viewer", "group:netops:operator"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Added 1 and updated 1 member(s) of project `Provisioning`", res.Text)
+
+	require.Len(t, *updates, 1)
+	assert.Equal(t, map[string]string{"a0": "owner", "a1": "viewer", "g1": "operator"}, projectMemberReferences((*updates)[0]))
+
+	// existing members with the same role and the active user are reported
+	// and nothing is sent to the server
+	res, err = runner.Add(Request{Args: []string{"Provisioning", "account:alice:editor", "account:admin:viewer"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Added 0 and updated 0 member(s) of project `Provisioning`\n"+
+		"Skipped account `alice`, already a member with role `editor`\n"+
+		"Skipped account `admin`, the active user is not changed", res.Text)
+	assert.Len(t, *updates, 1)
+
+	_, err = runner.Add(Request{Args: []string{"Provisioning", "account:unknown"}})
+	assert.ErrorContains(t, err, `account "unknown" not found`)
+}
+
+func TestProjectMembersRemove(t *testing.T) {
+	runner, updates := setupProjectMembersMux(t)
+	defer testlib.Teardown()
+
+	res, err := runner.Remove(Request{Args: []string{"Provisioning", "account:alice"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Removed 1 member(s) from project `Provisioning`", res.Text)
+
+	require.Len(t, *updates, 1)
+	assert.Equal(t, map[string]string{"a0": "owner"}, projectMemberReferences((*updates)[0]))
+
+	_, err = runner.Remove(Request{Args: []string{"Provisioning", "account:bob"}})
+	assert.EqualError(t, err, "member(s) not a member of project `Provisioning`: bob")
+	assert.Len(t, *updates, 1)
+}
+
+func TestProjectMembersSet(t *testing.T) {
+	runner, updates := setupProjectMembersMux(t)
+	defer testlib.Teardown()
+
+	fn := filepath.Join(t.TempDir(), "members.yaml")
+	require.NoError(t, os.WriteFile(fn, []byte("- account:bob:owner\n- type: group\n  name: netops\n  access: viewer\n"), 0644))
+
+	res, err := runner.Set(Request{
+		Args:    []string{"Provisioning"},
+		Options: &flags.ProjectMemberSetOptions{FromFile: fn},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully set 3 member(s) on project `Provisioning`", res.Text)
+
+	// alice is removed and the active user is kept
+	require.Len(t, *updates, 1)
+	assert.Equal(t, map[string]string{"a0": "owner", "a2": "owner", "g1": "viewer"}, projectMemberReferences((*updates)[0]))
+}
@@ -6,6 +6,7 @@ package runners
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -160,6 +161,8 @@ func (r *ProjectRunner) Describe(in Request) (*Response, error) {
 // This method implements the Writer interface and handles the `create project <name>` command.
 // It first checks if a project with the same name already exists and returns an error if found.
 // On success, it creates the project and returns confirmation with the project ID.
+// When --from-assets is specified, the project is built from existing global
+// assets instead of being created empty (see createFromAssets).
 //
 // Parameters:
 //   - in: Request with Args[0] containing the name for the new project
@@ -170,6 +173,9 @@ func (r *ProjectRunner) Describe(in Request) (*Response, error) {
 func (r *ProjectRunner) Create(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.ProjectCreateOptions
+	utils.LoadObject(in.Options, &options)
+
 	name := in.Args[0]
 
 	existing, err := r.resource.GetByName(name)
@@ -177,6 +183,14 @@ func (r *ProjectRunner) Create(in Request) (*Response, error) {
 		return nil, fmt.Errorf("project %q already exists", name)
 	}
 
+	if len(options.FromAssets) > 0 {
+		return r.createFromAssets(name, options)
+	}
+
+	if options.IncludeDependencies || options.DeleteOriginals {
+		return nil, errors.New("--include-dependencies and --delete-originals require --from-assets")
+	}
+
 	project, err := r.resource.Create(name)
 	if err != nil {
 		return nil, err
@@ -561,7 +575,7 @@ func (r *ProjectRunner) Export(in Request) (*Response, error) {
 
 		fn := fmt.Sprintf("%s.project.json", strings.Replace(name, "/", "_", -1))
 
-		if err := exportAssetFromRequest(in, exported, fn); err != nil {
+		if err := exportAssetFromRequest(in, "project", exported, fn); err != nil {
 			return nil, err
 		}
 	}
@@ -866,7 +880,8 @@ func (r *ProjectRunner) buildProjectMembers(
 }
 
 // parseMember parses a member specification string into a Member struct.
-// The format is: "type=<account|group>,name=<name>[,access=<role>]"
+// The format is: "type=<account|group>,name=<name>[,access=<role>]" or the
+// short form "<account|group>:<name>[:<role>]"
 //
 // Parameters:
 //   - member: Member specification string
@@ -879,16 +894,34 @@ func (r *ProjectRunner) buildProjectMembers(
 //   - "type=account,name=alice"
 //   - "type=account,name=alice,access=owner"
 //   - "type=group,name=devops,access=editor"
+//   - "account:alice:owner"
+//   - "group:devops"
 func parseMember(member string) (*Member, error) {
 	if member == "" {
 		return nil, fmt.Errorf("member specification cannot be empty")
 	}
 
-	parts := strings.Split(member, ",")
 	m := &Member{
 		Access: services.MemberRoleEditor, // Default access level
 	}
 
+	if !strings.Contains(member, "=") {
+		tokens := strings.Split(member, ":")
+		if len(tokens) < 2 || len(tokens) > 3 {
+			return nil, fmt.Errorf("invalid member specification %q (must be <type>:<name>[:<access>])", member)
+		}
+
+		m.Type = strings.TrimSpace(tokens[0])
+		m.Name = strings.TrimSpace(tokens[1])
+		if len(tokens) == 3 && strings.TrimSpace(tokens[2]) != "" {
+			m.Access = strings.TrimSpace(tokens[2])
+		}
+
+		return validateMember(m, member)
+	}
+
+	parts := strings.Split(member, ",")
+
 	seen := make(map[string]bool, 3)
 
 	for _, part := range parts {
@@ -926,6 +959,12 @@ func parseMember(member string) (*Member, error) {
 		}
 	}
 
+	return validateMember(m, member)
+}
+
+// validateMember checks the required fields, type and access level of a
+// parsed member specification.
+func validateMember(m *Member, member string) (*Member, error) {
 	// Validate required fields
 	if m.Type == "" {
 		return nil, fmt.Errorf("missing required 'type' field in member specification %q", member)
@@ -962,7 +1001,7 @@ func parseMember(member string) (*Member, error) {
 // of including the full component data.
 //
 // Parameters:
-//   - in: Request object (unused, kept for consistency with command pattern)
+//   - in: Request object, used to check if canonical output was requested
 //   - project: The project to expand to disk
 //   - path: Base directory path where the expanded project will be written
 //
@@ -990,8 +1029,19 @@ func expandProject(in Request, project *services.Project, path string) error {
 		}
 	}
 
+	projectFn := fmt.Sprintf("%s.project.json", strings.Replace(project.Name, "/", "_", -1))
+
 	var projectMap map[string]interface{}
-	if err := utils.ToMap(project, &projectMap); err != nil {
+
+	// The whole project is made canonical before it is split so the
+	// component documents keep the ids the project uses to reference them.
+	if canonicalRequested(in) {
+		document, err := canonicalAsset("project", project)
+		if err != nil {
+			return err
+		}
+		projectMap = document
+	} else if err := utils.ToMap(project, &projectMap); err != nil {
 		return err
 	}
 
@@ -1013,19 +1063,19 @@ func expandProject(in Request, project *services.Project, path string) error {
 			p = filepath.Join(path, normalizeProjectPath(ele.Folder))
 		}
 
+		component := components[idx].(map[string]interface{})
+
 		docName := strings.Replace(ele.Document["name"].(string), "/", "_", -1)
 		fn := fmt.Sprintf("%s.%s.json", docName, strings.ToLower(ele.Type))
-		if err := utils.WriteJsonToDisk(ele.Document, fn, p); err != nil {
+		if err := utils.WriteJsonToDisk(component["document"], fn, p); err != nil {
 			return err
 		}
 
-		delete(components[idx].(map[string]interface{}), "document")
-		components[idx].(map[string]interface{})["filename"] = fn
+		delete(component, "document")
+		component["filename"] = fn
 	}
 
 	projectMap["components"] = components
 
-	fn := fmt.Sprintf("%s.project.json", strings.Replace(project.Name, "/", "_", -1))
-
-	return utils.WriteJsonToDisk(projectMap, fn, path)
+	return utils.WriteJsonToDisk(projectMap, projectFn, path)
 }
@@ -0,0 +1,242 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// renameChange is a single update performed as part of renaming an asset.
+// The revert function restores the asset to the Before document.
+type renameChange struct {
+	Kind   string
+	Name   string
+	Before any
+	After  any
+	apply  func() error
+	revert func() error
+}
+
+// renamePlan is the set of changes required to rename an asset and update
+// every asset that references it.  The first change is always the asset
+// being renamed.
+type renamePlan struct {
+	Kind     string
+	From     string
+	To       string
+	Changes  []renameChange
+	Warnings []string
+}
+
+func newRenamePlan(kind, from, to string) *renamePlan {
+	return &renamePlan{Kind: kind, From: from, To: to}
+}
+
+func (p *renamePlan) add(kind, name string, before, after any, apply, revert func() error) {
+	p.Changes = append(p.Changes, renameChange{
+		Kind:   kind,
+		Name:   name,
+		Before: before,
+		After:  after,
+		apply:  apply,
+		revert: revert,
+	})
+}
+
+// Diff returns a unified diff of every change in the plan.
+func (p *renamePlan) Diff() (string, error) {
+	var diffs []string
+
+	for _, ele := range p.Changes {
+		a, err := json.MarshalIndent(ele.Before, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		b, err := json.MarshalIndent(ele.After, "", "  ")
+		if err != nil {
+			return "", err
+		}
+
+		path := fmt.Sprintf("%s/%s", ele.Kind, ele.Name)
+
+		diff, err := utils.UnifiedDiff(string(a)+"\n", string(b)+"\n", "a/"+path, "b/"+path)
+		if err != nil {
+			return "", err
+		}
+
+		diffs = append(diffs, strings.TrimRight(diff, "\n"))
+	}
+
+	return strings.Join(diffs, "\n"), nil
+}
+
+// Apply performs each change in order.  If a change fails, all of the
+// changes that were already applied are reverted in reverse order and an
+// error is returned.
+func (p *renamePlan) Apply() error {
+	logging.Trace()
+
+	for idx, ele := range p.Changes {
+		if err := ele.apply(); err != nil {
+			var failed []string
+
+			for i := idx - 1; i >= 0; i-- {
+				change := p.Changes[i]
+				if rerr := change.revert(); rerr != nil {
+					logging.Error(rerr, "failed to roll back %s `%s`", change.Kind, change.Name)
+					failed = append(failed, fmt.Sprintf("%s `%s`", change.Kind, change.Name))
+				}
+			}
+
+			if len(failed) > 0 {
+				return fmt.Errorf(
+					"failed to update %s `%s`: %s, unable to roll back %s",
+					ele.Kind, ele.Name, err, strings.Join(failed, ", "),
+				)
+			}
+
+			return fmt.Errorf(
+				"failed to update %s `%s`: %s, rolled back %v change(s)",
+				ele.Kind, ele.Name, err, idx,
+			)
+		}
+	}
+
+	return nil
+}
+
+// renameResponse displays the plan when `--dry-run` is set and otherwise
+// applies it.
+func renameResponse(in Request, plan *renamePlan) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetRenameCommon)
+
+	var output []string
+
+	if common.DryRun {
+		diff, err := plan.Diff()
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, diff, "")
+	} else {
+		if err := plan.Apply(); err != nil {
+			return nil, err
+		}
+		for _, ele := range plan.Changes[1:] {
+			output = append(output, fmt.Sprintf("Updated %s `%s`", ele.Kind, ele.Name))
+		}
+	}
+
+	for _, ele := range plan.Warnings {
+		output = append(output, "WARNING: "+ele)
+	}
+
+	if common.DryRun {
+		output = append(output, fmt.Sprintf(
+			"Renaming %s `%s` to `%s` will update %v referencing asset(s), no changes were made",
+			plan.Kind, plan.From, plan.To, len(plan.Changes)-1,
+		))
+	} else {
+		output = append(output, fmt.Sprintf(
+			"Successfully renamed %s `%s` to `%s` and updated %v referencing asset(s)",
+			plan.Kind, plan.From, plan.To, len(plan.Changes)-1,
+		))
+	}
+
+	return &Response{
+		Text: strings.TrimLeft(strings.Join(output, "\n"), "\n"),
+	}, nil
+}
+
+// cloneWorkflow returns a deep copy of the workflow so the tasks can be
+// modified without changing the original.
+func cloneWorkflow(in services.Workflow) (services.Workflow, error) {
+	var res services.Workflow
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		return res, err
+	}
+
+	if err := json.Unmarshal(b, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// renameTaskReferences updates every task in the workflow that references
+// the asset of the specified kind by name and returns the number of tasks
+// that were changed.
+func renameTaskReferences(wf *services.Workflow, kind, from, to string) int {
+	var count int
+
+	for _, key := range workflowTaskKeys(*wf) {
+		task := workflowTaskMap(*wf, key)
+		incoming := workflowTaskIncoming(task)
+		if incoming == nil {
+			continue
+		}
+
+		var field string
+
+		switch kind {
+		case dependencyWorkflow:
+			if workflowTaskString(task, "name") == "childJob" {
+				field = "workflow"
+			}
+		case dependencyTemplate:
+			if workflowTaskString(task, "app") == "TemplateBuilder" {
+				field = "template"
+			}
+		}
+
+		if field != "" && incoming[field] == from {
+			incoming[field] = to
+			count++
+		}
+	}
+
+	return count
+}
+
+// renameWorkflowReferences adds a change to the plan for every workflow,
+// other than skip, with tasks that reference the renamed asset.
+func renameWorkflowReferences(svc *services.WorkflowService, plan *renamePlan, workflows []services.Workflow, skip string) error {
+	logging.Trace()
+
+	for _, ele := range workflows {
+		if ele.Name == skip {
+			continue
+		}
+
+		before := ele
+
+		after, err := cloneWorkflow(before)
+		if err != nil {
+			return err
+		}
+
+		if renameTaskReferences(&after, plan.Kind, plan.From, plan.To) == 0 {
+			continue
+		}
+
+		plan.add(dependencyWorkflow, before.Name, before, after,
+			func() error { _, err := svc.Update(after); return err },
+			func() error { _, err := svc.Update(before); return err },
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// renameTestServer records the updates made by a rename so the tests can
+// verify which assets were changed and in what order.
+type renameTestServer struct {
+	sync.Mutex
+	updates []string
+	bodies  []map[string]interface{}
+}
+
+func (s *renameTestServer) record(kind string, r *http.Request) map[string]interface{} {
+	s.Lock()
+	defer s.Unlock()
+
+	var body map[string]interface{}
+	b, _ := io.ReadAll(r.Body)
+	json.Unmarshal(b, &body)
+
+	s.updates = append(s.updates, kind+"/"+r.PathValue("id"))
+	s.bodies = append(s.bodies, body)
+
+	return body
+}
+
+func renameTestWorkflow(id, name, child string) services.Workflow {
+	wf := services.NewWorkflow(name)
+	wf.Id = id
+	if child != "" {
+		wf.Tasks["c1"] = map[string]interface{}{
+			"name": "childJob",
+			"app":  "WorkFlowEngine",
+			"variables": map[string]interface{}{
+				"incoming": map[string]interface{}{"workflow": child},
+			},
+		}
+	}
+	return wf
+}
+
+func setupWorkflowRenameMux(t *testing.T, failAutomation bool) *renameTestServer {
+	server := &renameTestServer{}
+
+	workflows := []services.Workflow{
+		renameTestWorkflow("w1", "child", ""),
+		renameTestWorkflow("w2", "parent", "child"),
+		renameTestWorkflow("w3", "other", "unrelated"),
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"items": workflows, "total": len(workflows)})
+	require.NoError(t, err)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", string(b), 0)
+
+	testlib.AddGetResponseToMux("/operations-manager/automations", `{
+		"data": [
+			{"_id": "a1", "name": "Run Child", "componentType": "workflows", "componentName": "child"},
+			{"_id": "a2", "name": "Run Other", "componentType": "workflows", "componentName": "other"}
+		],
+		"metadata": {"total": 2}
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/prebuilts", `{
+		"results": [
+			{"_id": "p1", "name": "@itential/pb", "components": [{"id": "w1", "name": "child", "type": "workflow"}]}
+		],
+		"total": 1
+	}`, 0)
+
+	testlib.AddHandlerToMux("PUT /automation-studio/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body := server.record(dependencyWorkflow, r)
+		json.NewEncoder(w).Encode(body["update"])
+	})
+
+	testlib.AddHandlerToMux("PATCH /operations-manager/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server.record(dependencyAutomation, r)
+		if failAutomation {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"message": "updated", "data": {}}`))
+	})
+
+	return server
+}
+
+func TestRenamePlanApplyRollback(t *testing.T) {
+	var calls []string
+
+	plan := newRenamePlan(dependencyWorkflow, "a", "b")
+	for _, name := range []string{"one", "two", "three"} {
+		plan.add(dependencyWorkflow, name, nil, nil,
+			func() error {
+				calls = append(calls, "apply "+name)
+				if name == "three" {
+					return errors.New("boom")
+				}
+				return nil
+			},
+			func() error { calls = append(calls, "revert "+name); return nil },
+		)
+	}
+
+	err := plan.Apply()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to update workflow `three`: boom, rolled back 2 change(s)")
+
+	assert.Equal(t, []string{
+		"apply one", "apply two", "apply three", "revert two", "revert one",
+	}, calls)
+}
+
+func TestRenameTaskReferences(t *testing.T) {
+	wf := renameTestWorkflow("w2", "parent", "child")
+	wf.Tasks["t1"] = map[string]interface{}{
+		"name": "renderJinjaTemplate",
+		"app":  "TemplateBuilder",
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"template": "child"},
+		},
+	}
+
+	assert.Equal(t, 1, renameTaskReferences(&wf, dependencyTemplate, "child", "renamed"))
+	assert.Equal(t, "renamed", workflowTaskIncoming(workflowTaskMap(wf, "t1"))["template"])
+	assert.Equal(t, "child", workflowChildName(workflowTaskMap(wf, "c1")))
+
+	assert.Equal(t, 1, renameTaskReferences(&wf, dependencyWorkflow, "child", "renamed"))
+	assert.Equal(t, "renamed", workflowChildName(workflowTaskMap(wf, "c1")))
+}
+
+func TestWorkflowRenameDryRun(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	server := setupWorkflowRenameMux(t, false)
+
+	res, err := runner.Rename(Request{
+		Args:   []string{"child", "child-v2"},
+		Common: &flags.AssetRenameCommon{DryRun: true},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, server.updates)
+
+	assert.Contains(t, res.Text, "--- a/workflow/child")
+	assert.Contains(t, res.Text, `+  "name": "child-v2",`)
+	assert.Contains(t, res.Text, "--- a/workflow/parent")
+	assert.Contains(t, res.Text, `+          "workflow": "child-v2"`)
+	assert.Contains(t, res.Text, "--- a/automation/Run Child")
+	assert.Contains(t, res.Text, `+  "componentName": "child-v2",`)
+	assert.NotContains(t, res.Text, "a/workflow/other")
+	assert.Contains(t, res.Text, "WARNING: prebuilt `@itential/pb` includes workflow `child`")
+	assert.True(t, strings.HasSuffix(res.Text, "will update 2 referencing asset(s), no changes were made"))
+}
+
+func TestWorkflowRename(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	server := setupWorkflowRenameMux(t, false)
+
+	res, err := runner.Rename(Request{
+		Args:   []string{"child", "child-v2"},
+		Common: &flags.AssetRenameCommon{},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workflow/w1", "workflow/w2", "automation/a1"}, server.updates)
+	assert.Equal(t, "child-v2", server.bodies[0]["update"].(map[string]interface{})["name"])
+	assert.Equal(t, "child-v2", server.bodies[2]["componentName"])
+	assert.Contains(t, res.Text, "Successfully renamed workflow `child` to `child-v2` and updated 2 referencing asset(s)")
+}
+
+func TestWorkflowRenameRollback(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	server := setupWorkflowRenameMux(t, true)
+
+	_, err := runner.Rename(Request{
+		Args:   []string{"child", "child-v2"},
+		Common: &flags.AssetRenameCommon{},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rolled back 2 change(s)")
+
+	assert.Equal(t, []string{
+		"workflow/w1", "workflow/w2", "automation/a1", "workflow/w2", "workflow/w1",
+	}, server.updates)
+	assert.Equal(t, "child", server.bodies[4]["update"].(map[string]interface{})["name"])
+}
+
+func TestWorkflowRenameExists(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	setupWorkflowRenameMux(t, false)
+
+	_, err := runner.Rename(Request{
+		Args:   []string{"child", "parent"},
+		Common: &flags.AssetRenameCommon{},
+	})
+	assert.EqualError(t, err, "workflow `parent` already exists")
+
+	_, err = runner.Rename(Request{
+		Args:   []string{"missing", "new"},
+		Common: &flags.AssetRenameCommon{},
+	})
+	assert.EqualError(t, err, "workflow `missing` not found")
+}
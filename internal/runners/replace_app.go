@@ -0,0 +1,230 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// ReplaceAppRunner implements the `refactor replace-app` command.
+type ReplaceAppRunner struct {
+	BaseRunner
+}
+
+func NewReplaceAppRunner(c client.Client, cfg config.Provider) *ReplaceAppRunner {
+	return &ReplaceAppRunner{
+		BaseRunner: NewBaseRunner(c, cfg),
+	}
+}
+
+// Refactor implements the `refactor replace-app --from <app> --to <app>`
+// command.  Every workflow task that calls the `--from` adapter or
+// application is rewritten to call `--to` instead.  Method names are
+// translated using `--method-map` when specified.  A diff is displayed for
+// each workflow and the original workflows are written to a backup directory
+// before any changes are made.
+func (r *ReplaceAppRunner) Refactor(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.ReplaceAppOptions)
+
+	if options.From == options.To {
+		return nil, errors.New("`--from` and `--to` must be different")
+	}
+
+	methods, err := loadReplaceAppMethodMap(options.MethodMap)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := r.scopedWorkflows(options.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := services.NewWorkflowService(r.client)
+	plan := newRenamePlan(dependencyWorkflow, options.From, options.To)
+
+	for _, ele := range workflows {
+		before := ele
+
+		after, err := cloneWorkflow(before)
+		if err != nil {
+			return nil, err
+		}
+
+		if replaceWorkflowApp(&after, options.From, options.To, methods) == 0 {
+			continue
+		}
+
+		plan.add(dependencyWorkflow, before.Name, before, after,
+			func() error { _, err := svc.Update(after); return err },
+			func() error { _, err := svc.Update(before); return err },
+		)
+	}
+
+	if len(plan.Changes) == 0 {
+		return &Response{
+			Text: fmt.Sprintf("No workflows reference `%s`", options.From),
+		}, nil
+	}
+
+	diff, err := plan.Diff()
+	if err != nil {
+		return nil, err
+	}
+
+	output := []string{diff, ""}
+
+	if options.DryRun {
+		output = append(output, fmt.Sprintf(
+			"Replacing `%s` with `%s` will update %v workflow(s), no changes were made",
+			options.From, options.To, len(plan.Changes),
+		))
+		return &Response{Text: strings.Join(output, "\n")}, nil
+	}
+
+	backupDir := options.BackupDir
+	if backupDir == "" {
+		backupDir = fmt.Sprintf("replace-app-backup-%s", time.Now().Format("20060102150405"))
+	}
+
+	for _, ele := range plan.Changes {
+		fn := fmt.Sprintf("%s.workflow.json", ele.Name)
+		if err := utils.WriteJsonToDisk(ele.Before, fn, backupDir); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := plan.Apply(); err != nil {
+		return nil, err
+	}
+
+	output = append(output,
+		fmt.Sprintf("Original workflows saved to `%s`", backupDir),
+		fmt.Sprintf("Successfully replaced `%s` with `%s` in %v workflow(s)", options.From, options.To, len(plan.Changes)),
+	)
+
+	return &Response{Text: strings.Join(output, "\n")}, nil
+}
+
+// scopedWorkflows returns the workflows selected by the `--scope` option.  An
+// empty scope selects every workflow on the server and `project:<name>`
+// selects only the workflows in the named project.
+func (r *ReplaceAppRunner) scopedWorkflows(scope string) ([]services.Workflow, error) {
+	logging.Trace()
+
+	workflows, err := resources.NewWorkflowResource(services.NewWorkflowService(r.client)).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if scope == "" {
+		return workflows, nil
+	}
+
+	kind, name, found := strings.Cut(scope, ":")
+	if !found || kind != "project" || name == "" {
+		return nil, fmt.Errorf("unsupported scope `%s`, must be `project:<name>`", scope)
+	}
+
+	project, err := resources.NewProjectResource(services.NewProjectService(r.client)).GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, ele := range project.Components {
+		if ele.Type == dependencyWorkflow {
+			ids[ele.Reference] = true
+		}
+	}
+
+	var res []services.Workflow
+	for _, ele := range workflows {
+		if ids[ele.Id] {
+			res = append(res, ele)
+		}
+	}
+
+	return res, nil
+}
+
+// loadReplaceAppMethodMap loads the map of old method names to new method
+// names from an inline JSON object or a JSON or YAML file using the `@`
+// prefix.
+func loadReplaceAppMethodMap(in string) (map[string]string, error) {
+	logging.Trace()
+
+	methods := map[string]string{}
+
+	if in == "" {
+		return methods, nil
+	}
+
+	b, err := readArgData(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.UnmarshalData(b, &methods); err != nil {
+		return nil, fmt.Errorf("failed to load method map: %s", err)
+	}
+
+	return methods, nil
+}
+
+// replaceWorkflowApp rewrites the tasks in the workflow that reference the
+// from adapter or application and returns the number of tasks that were
+// changed.  A task references the app using the `app` field, the
+// `adapter_id` field or the `adapter_id` incoming variable.  Method names
+// are only translated for tasks that were changed.
+func replaceWorkflowApp(wf *services.Workflow, from, to string, methods map[string]string) int {
+	var count int
+
+	for _, key := range workflowTaskKeys(*wf) {
+		task := workflowTaskMap(*wf, key)
+		if task == nil {
+			continue
+		}
+
+		var changed bool
+
+		for _, field := range []string{"app", "adapter_id"} {
+			if workflowTaskString(task, field) == from {
+				task[field] = to
+				changed = true
+			}
+		}
+
+		if incoming := workflowTaskIncoming(task); incoming != nil && incoming["adapter_id"] == from {
+			incoming["adapter_id"] = to
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+
+		if method, exists := methods[workflowTaskString(task, "name")]; exists {
+			task["name"] = method
+		}
+
+		count++
+	}
+
+	return count
+}
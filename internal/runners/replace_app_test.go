@@ -0,0 +1,151 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func replaceAppTestWorkflow(id, name, adapter string) services.Workflow {
+	wf := services.NewWorkflow(name)
+	wf.Id = id
+	wf.Tasks["a1"] = map[string]interface{}{
+		"name":       "getIncident",
+		"app":        "ServiceNow",
+		"adapter_id": adapter,
+		"variables": map[string]interface{}{
+			"incoming": map[string]interface{}{"adapter_id": adapter, "id": "INC1"},
+		},
+	}
+	wf.Tasks["a2"] = map[string]interface{}{
+		"name": "query",
+		"app":  "WorkFlowEngine",
+	}
+	return wf
+}
+
+func setupReplaceAppMux(t *testing.T) *renameTestServer {
+	server := &renameTestServer{}
+
+	workflows := []services.Workflow{
+		replaceAppTestWorkflow("w1", "incidents", "ServiceNow-Old"),
+		replaceAppTestWorkflow("w2", "changes", "ServiceNow-Old"),
+		replaceAppTestWorkflow("w3", "current", "ServiceNow"),
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"items": workflows, "total": len(workflows)})
+	require.NoError(t, err)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", string(b), 0)
+
+	testlib.AddGetResponseToMux("/automation-studio/projects", `{
+		"data": [
+			{"_id": "p1", "name": "Changes", "components": [{"iid": 1, "type": "workflow", "reference": "w2"}]}
+		],
+		"metadata": {"total": 1}
+	}`, 0)
+
+	testlib.AddHandlerToMux("PUT /automation-studio/automations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		body := server.record(dependencyWorkflow, r)
+		json.NewEncoder(w).Encode(body["update"])
+	})
+
+	return server
+}
+
+func TestReplaceWorkflowApp(t *testing.T) {
+	wf := replaceAppTestWorkflow("w1", "incidents", "ServiceNow-Old")
+
+	count := replaceWorkflowApp(&wf, "ServiceNow-Old", "ServiceNow-New", map[string]string{"getIncident": "getIncidentById"})
+	assert.Equal(t, 1, count)
+
+	task := workflowTaskMap(wf, "a1")
+	assert.Equal(t, "ServiceNow-New", task["adapter_id"])
+	assert.Equal(t, "ServiceNow", task["app"])
+	assert.Equal(t, "getIncidentById", task["name"])
+	assert.Equal(t, "ServiceNow-New", workflowTaskIncoming(task)["adapter_id"])
+
+	assert.Equal(t, "query", workflowTaskMap(wf, "a2")["name"])
+	assert.Equal(t, 0, replaceWorkflowApp(&wf, "ServiceNow-Old", "ServiceNow-New", nil))
+}
+
+func TestReplaceAppDryRun(t *testing.T) {
+	runner := NewReplaceAppRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	server := setupReplaceAppMux(t)
+
+	res, err := runner.Refactor(Request{
+		Options: &flags.ReplaceAppOptions{
+			From:      "ServiceNow-Old",
+			To:        "ServiceNow",
+			MethodMap: `{"getIncident": "getIncidentById"}`,
+			DryRun:    true,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, server.updates)
+
+	assert.Contains(t, res.Text, "--- a/workflow/incidents")
+	assert.Contains(t, res.Text, "--- a/workflow/changes")
+	assert.NotContains(t, res.Text, "a/workflow/current")
+	assert.Contains(t, res.Text, `+      "name": "getIncidentById",`)
+	assert.Contains(t, res.Text, "will update 2 workflow(s), no changes were made")
+}
+
+func TestReplaceAppScope(t *testing.T) {
+	runner := NewReplaceAppRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	server := setupReplaceAppMux(t)
+
+	dir := t.TempDir()
+
+	res, err := runner.Refactor(Request{
+		Options: &flags.ReplaceAppOptions{
+			From:      "ServiceNow-Old",
+			To:        "ServiceNow",
+			Scope:     "project:Changes",
+			BackupDir: dir,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workflow/w2"}, server.updates)
+	assert.Contains(t, res.Text, "Successfully replaced `ServiceNow-Old` with `ServiceNow` in 1 workflow(s)")
+
+	b, err := os.ReadFile(filepath.Join(dir, "changes.workflow.json"))
+	require.NoError(t, err)
+
+	var backup services.Workflow
+	require.NoError(t, json.Unmarshal(b, &backup))
+	assert.Equal(t, "ServiceNow-Old", workflowTaskMap(backup, "a1")["adapter_id"])
+
+	_, err = runner.Refactor(Request{
+		Options: &flags.ReplaceAppOptions{
+			From:  "ServiceNow-Old",
+			To:    "ServiceNow",
+			Scope: "folder:Changes",
+		},
+	})
+	assert.EqualError(t, err, "unsupported scope `folder:Changes`, must be `project:<name>`")
+}
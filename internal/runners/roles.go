@@ -305,9 +305,6 @@ func (r *RoleRunner) Export(in Request) (*Response, error) {
 	name := in.Args[0]
 	roleType := "Custom"
 
-	var common flags.AssetExportCommon
-	utils.LoadObject(in.Common, &common)
-
 	var options flags.RoleExportOptions
 	utils.LoadObject(in.Options, &options)
 
@@ -346,7 +343,7 @@ func (r *RoleRunner) Export(in Request) (*Response, error) {
 
 	fn := fmt.Sprintf("%s.role.json", name)
 
-	if err := utils.WriteJsonToDisk(role, fn, common.Path); err != nil {
+	if err := exportAssetFromRequest(in, "role", role, fn); err != nil {
 		return nil, err
 	}
 
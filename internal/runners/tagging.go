@@ -0,0 +1,272 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/resources"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+// tagSelector selects assets by the tag attached to them.  A selector
+// created without a tag matches every asset.
+type tagSelector struct {
+	service *services.TagService
+	tag     *services.Tag
+
+	// refs holds the ids of the assets with the tag.  It is loaded once
+	// on the first call to Match.
+	refs map[string]bool
+}
+
+// newTagSelector returns a selector for the named tag.  An error is returned
+// if the tag does not exist on the server.
+func newTagSelector(c client.Client, name string) (*tagSelector, error) {
+	logging.Trace()
+
+	selector := &tagSelector{service: services.NewTagService(c)}
+
+	if name == "" {
+		return selector, nil
+	}
+
+	tags, err := selector.service.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ele := range tags {
+		if ele.Name == name {
+			selector.tag = &ele
+			return selector, nil
+		}
+	}
+
+	return nil, fmt.Errorf("tag `%s` does not exist", name)
+}
+
+// Match returns true if the asset with the id has the tag attached to it.
+// The references of the tag are requested from the server once and reused
+// for every asset.
+func (s *tagSelector) Match(id string) (bool, error) {
+	if s.tag == nil {
+		return true, nil
+	}
+
+	if s.refs == nil {
+		refs, err := s.service.GetReferencesForTag(s.tag.Id)
+		if err != nil {
+			return false, err
+		}
+
+		s.refs = map[string]bool{}
+		for _, ele := range refs {
+			s.refs[ele.RefId] = true
+		}
+	}
+
+	return s.refs[id], nil
+}
+
+// taggedAssetNames returns the names of all assets of the kind that have the
+// tag attached to them.  Assets that belong to a project, whose names start
+// with `@`, are never returned.
+func taggedAssetNames(c client.Client, kind, tag string) ([]string, error) {
+	logging.Trace()
+
+	selector, err := newTagSelector(c, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	type asset struct{ id, name string }
+
+	var assets []asset
+
+	switch kind {
+	case dependencyWorkflow:
+		res, err := resources.NewWorkflowResource(services.NewWorkflowService(c)).GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, ele := range res {
+			assets = append(assets, asset{ele.Id, ele.Name})
+		}
+
+	case dependencyTemplate:
+		res, err := resources.NewTemplateResource(services.NewTemplateService(c)).GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, ele := range res {
+			assets = append(assets, asset{ele.Id, ele.Name})
+		}
+
+	case dependencyTransformation:
+		res, err := resources.NewTransformationResource(services.NewTransformationService(c)).GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, ele := range res {
+			assets = append(assets, asset{ele.Id, ele.Name})
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported asset type `%s`", kind)
+	}
+
+	var names []string
+
+	for _, ele := range assets {
+		if strings.HasPrefix(ele.name, "@") {
+			continue
+		}
+		matched, err := selector.Match(ele.id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			names = append(names, ele.name)
+		}
+	}
+
+	return names, nil
+}
+
+// copyTagged copies every asset of the kind with the tag from the source
+// server to the destination server.  The tag is looked up on the source
+// server.
+func copyTagged(in Request, kind, tag string, r Copier, cfg config.Provider) (*Response, error) {
+	logging.Trace()
+
+	common := in.Common.(*flags.AssetCopyCommon)
+
+	c, cancel, err := NewClient(common.From, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	names, err := taggedAssetNames(c, kind, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return &Response{
+			Text: fmt.Sprintf("No %s assets have the tag `%s`, nothing was copied", kind, tag),
+		}, nil
+	}
+
+	var output []string
+
+	for _, name := range names {
+		req := in
+		req.Args = []string{name}
+
+		res, err := Copy(CopyRequest{Request: req, Type: kind}, r)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, fmt.Sprintf("Successfully copied %s `%s` from `%s` to `%s`", kind, res.Name, res.From, res.To))
+	}
+
+	return &Response{Text: strings.Join(output, "\n")}, nil
+}
+
+// tagAsset attaches and detaches tags on a single asset.  Tags that are added
+// but do not exist yet are created.  Adding a tag that is already attached or
+// removing one that is not attached is not an error so the command can be
+// safely run more than once.
+func tagAsset(c client.Client, kind, id, name string, options *flags.AssetTagOptions) (*Response, error) {
+	logging.Trace()
+
+	if len(options.Add) == 0 && len(options.Remove) == 0 {
+		return nil, errors.New("at least one of `--add` or `--remove` must be specified")
+	}
+
+	svc := services.NewTagService(c)
+
+	tags, err := svc.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]services.Tag{}
+	for _, ele := range tags {
+		byName[ele.Name] = ele
+	}
+
+	current, err := svc.GetTagsForReference(id)
+	if err != nil {
+		return nil, err
+	}
+
+	attached := map[string]bool{}
+	for _, ele := range current {
+		attached[ele.Id] = true
+	}
+
+	var output []string
+
+	for _, ele := range options.Add {
+		ele = strings.TrimSpace(ele)
+		if ele == "" {
+			continue
+		}
+
+		tag, exists := byName[ele]
+		if !exists {
+			created, err := svc.Create(services.NewTag(ele, ""))
+			if err != nil {
+				return nil, err
+			}
+			tag = *created
+			byName[ele] = tag
+			output = append(output, fmt.Sprintf("Created tag `%s`", ele))
+		}
+
+		if attached[tag.Id] {
+			continue
+		}
+
+		if err := svc.CreateReference(tag.Id, id, kind); err != nil {
+			return nil, err
+		}
+		attached[tag.Id] = true
+
+		output = append(output, fmt.Sprintf("Added tag `%s` to %s `%s`", ele, kind, name))
+	}
+
+	for _, ele := range options.Remove {
+		ele = strings.TrimSpace(ele)
+
+		tag, exists := byName[ele]
+		if !exists || !attached[tag.Id] {
+			continue
+		}
+
+		if err := svc.DeleteReference(tag.Id, id); err != nil {
+			return nil, err
+		}
+		delete(attached, tag.Id)
+
+		output = append(output, fmt.Sprintf("Removed tag `%s` from %s `%s`", ele, kind, name))
+	}
+
+	if len(output) == 0 {
+		output = append(output, fmt.Sprintf("No changes were made to the tags of %s `%s`", kind, name))
+	}
+
+	return &Response{Text: strings.Join(output, "\n")}, nil
+}
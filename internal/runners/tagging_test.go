@@ -0,0 +1,223 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tagTestServer records the tag references created and deleted by the tag
+// commands.
+type tagTestServer struct {
+	sync.Mutex
+	created    []string
+	lookups    int
+	references []map[string]string
+	deleted    []map[string]string
+}
+
+func tagTestData(r *http.Request) map[string]string {
+	var body struct {
+		Data map[string]string `json:"data"`
+	}
+	b, _ := io.ReadAll(r.Body)
+	json.Unmarshal(b, &body)
+	return body.Data
+}
+
+func setupTaggingMux(t *testing.T) *tagTestServer {
+	server := &tagTestServer{}
+
+	workflows := []services.Workflow{
+		renameTestWorkflow("w1", "ip-assign", ""),
+		renameTestWorkflow("w2", "ip-release", ""),
+		renameTestWorkflow("w3", "backup", ""),
+		renameTestWorkflow("w4", "@p1: ip-assign", ""),
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"items": workflows, "total": len(workflows)})
+	require.NoError(t, err)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", string(b), 0)
+
+	testlib.AddGetResponseToMux("/tags/all", `[
+		{"_id": "t1", "name": "release-1.4"},
+		{"_id": "t2", "name": "netops"}
+	]`, 0)
+
+	references := map[string]string{
+		"w1": `[{"_id": "t1", "name": "release-1.4"}]`,
+		"w2": `[{"_id": "t1", "name": "release-1.4"}, {"_id": "t2", "name": "netops"}]`,
+	}
+
+	testlib.AddHandlerToMux("POST /tags/getTagsByReference", func(w http.ResponseWriter, r *http.Request) {
+		if res, exists := references[tagTestData(r)["ref_id"]]; exists {
+			w.Write([]byte(res))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	tagged := map[string]string{
+		"t1": `[
+			{"tag_id": "t1", "ref_id": "w1", "type": "workflow"},
+			{"tag_id": "t1", "ref_id": "w2", "type": "workflow"},
+			{"tag_id": "t1", "ref_id": "w4", "type": "workflow"}
+		]`,
+		"t2": `[{"tag_id": "t2", "ref_id": "w2", "type": "workflow"}]`,
+	}
+
+	testlib.AddHandlerToMux("POST /tags/getTagReferences", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		server.lookups++
+		if res, exists := tagged[tagTestData(r)["tag_id"]]; exists {
+			w.Write([]byte(res))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	testlib.AddHandlerToMux("POST /tags/create", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		name := tagTestData(r)["name"]
+		server.created = append(server.created, name)
+		json.NewEncoder(w).Encode(services.Tag{Id: "new-" + name, Name: name})
+	})
+
+	testlib.AddHandlerToMux("POST /tags/createReference", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		server.references = append(server.references, tagTestData(r))
+		w.Write([]byte(`{}`))
+	})
+
+	testlib.AddHandlerToMux("POST /tags/deleteReference", func(w http.ResponseWriter, r *http.Request) {
+		server.Lock()
+		defer server.Unlock()
+		server.deleted = append(server.deleted, tagTestData(r))
+		w.Write([]byte(`{}`))
+	})
+
+	return server
+}
+
+func TestTagAsset(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	server := setupTaggingMux(t)
+
+	res, err := tagAsset(client, dependencyWorkflow, "w2", "ip-release", &flags.AssetTagOptions{
+		Add:    []string{"release-1.4", "release-1.5"},
+		Remove: []string{"netops", "missing"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Created tag `release-1.5`\n"+
+		"Added tag `release-1.5` to workflow `ip-release`\n"+
+		"Removed tag `netops` from workflow `ip-release`", res.Text)
+
+	assert.Equal(t, []string{"release-1.5"}, server.created)
+	assert.Equal(t, []map[string]string{{"tag_id": "new-release-1.5", "ref_id": "w2", "type": "workflow"}}, server.references)
+	assert.Equal(t, []map[string]string{{"tag_id": "t2", "ref_id": "w2"}}, server.deleted)
+
+	res, err = tagAsset(client, dependencyWorkflow, "w1", "ip-assign", &flags.AssetTagOptions{
+		Add: []string{"release-1.4"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "No changes were made to the tags of workflow `ip-assign`", res.Text)
+
+	_, err = tagAsset(client, dependencyWorkflow, "w1", "ip-assign", &flags.AssetTagOptions{})
+	assert.EqualError(t, err, "at least one of `--add` or `--remove` must be specified")
+}
+
+func TestTaggedAssetNames(t *testing.T) {
+	client := testlib.Setup()
+	defer testlib.Teardown()
+
+	server := setupTaggingMux(t)
+
+	// the project workflow `@p1: ip-assign` has the tag but is not returned
+	names, err := taggedAssetNames(client, dependencyWorkflow, "release-1.4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ip-assign", "ip-release"}, names)
+
+	// the references of the tag are requested once for all workflows
+	assert.Equal(t, 1, server.lookups)
+
+	names, err = taggedAssetNames(client, dependencyWorkflow, "netops")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ip-release"}, names)
+
+	_, err = taggedAssetNames(client, dependencyWorkflow, "unknown")
+	assert.EqualError(t, err, "tag `unknown` does not exist")
+}
+
+func TestWorkflowGetTagged(t *testing.T) {
+	runner := NewWorkflowRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	setupTaggingMux(t)
+
+	res, err := runner.Get(Request{Options: &flags.WorkflowGetOptions{Tag: "netops"}})
+	require.NoError(t, err)
+
+	workflows := res.Object.([]services.Workflow)
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "ip-release", workflows[0].Name)
+}
+
+func TestWorkflowExportTagged(t *testing.T) {
+	runner := NewWorkflowRunner(testlib.Setup(), testlib.DefaultConfig())
+	defer testlib.Teardown()
+
+	setupTaggingMux(t)
+
+	testlib.AddHandlerToMux("POST /workflow_builder/export", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Options map[string]string `json:"options"`
+		}
+		b, _ := io.ReadAll(r.Body)
+		json.Unmarshal(b, &body)
+		json.NewEncoder(w).Encode(services.NewWorkflow(body.Options["name"]))
+	})
+
+	dir := t.TempDir()
+
+	res, err := runner.Export(Request{
+		Common:  &flags.AssetExportCommon{Path: dir},
+		Options: &flags.WorkflowExportOptions{Tag: "release-1.4"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully exported 2 workflow(s) with the tag `release-1.4`", res.Text)
+
+	for _, ele := range []string{"ip-assign", "ip-release"} {
+		_, err := os.Stat(filepath.Join(dir, ele+".workflow.json"))
+		assert.NoError(t, err)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "backup.workflow.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = runner.Export(Request{
+		Common:  &flags.AssetExportCommon{Path: dir},
+		Options: &flags.WorkflowExportOptions{Tag: "release-1.4", WithDependencies: true},
+	})
+	assert.EqualError(t, err, "--tag cannot be used with --with-dependencies")
+}
@@ -5,6 +5,7 @@
 package runners
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -14,20 +15,26 @@ import (
 	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/internal/terminal"
+	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
 	"github.com/itential/ipctl/pkg/resources"
 	"github.com/itential/ipctl/pkg/services"
 )
 
 type TemplateRunner struct {
-	resource resources.TemplateResourcer
+	resource       resources.TemplateResourcer
+	service        *services.TemplateService
+	config_manager *services.ConfigManagerService
 	BaseRunner
 }
 
 func NewTemplateRunner(c client.Client, cfg config.Provider) *TemplateRunner {
+	svc := services.NewTemplateService(c)
 	return &TemplateRunner{
-		resource:   resources.NewTemplateResource(services.NewTemplateService(c)),
-		BaseRunner: NewBaseRunner(c, cfg),
+		resource:       resources.NewTemplateResource(svc),
+		service:        svc,
+		config_manager: services.NewConfigManagerService(c),
+		BaseRunner:     NewBaseRunner(c, cfg),
 	}
 }
 
@@ -43,6 +50,11 @@ func (r *TemplateRunner) Get(in Request) (*Response, error) {
 
 	options := in.Options.(*flags.TemplateGetOptions)
 
+	selector, err := newTagSelector(r.client, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := r.resource.GetAll()
 	if err != nil {
 		return nil, err
@@ -51,9 +63,14 @@ func (r *TemplateRunner) Get(in Request) (*Response, error) {
 	var templates []services.Template
 
 	for _, ele := range res {
-		if strings.HasPrefix(ele.Name, "@") && options.All {
-			templates = append(templates, ele)
-		} else if !strings.HasPrefix(ele.Name, "@") {
+		if strings.HasPrefix(ele.Name, "@") && !options.All {
+			continue
+		}
+		matched, err := selector.Match(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
 			templates = append(templates, ele)
 		}
 	}
@@ -182,6 +199,13 @@ Copier interface
 func (r *TemplateRunner) Copy(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.TemplateCopyOptions
+	utils.LoadObject(in.Options, &options)
+
+	if options.Tag != "" {
+		return copyTagged(in, dependencyTemplate, options.Tag, r, r.config)
+	}
+
 	res, err := Copy(CopyRequest{Request: in, Type: "template"}, r)
 	if err != nil {
 		return nil, err
@@ -258,7 +282,7 @@ func (r *TemplateRunner) Import(in Request) (*Response, error) {
 
 	var res services.Template
 
-	if err := importUnmarshalFromRequest(in, &res); err != nil {
+	if err := importExpandedFromRequest(in, &res, nil, "template"); err != nil {
 		return nil, err
 	}
 
@@ -280,21 +304,48 @@ Exporter interface
 func (r *TemplateRunner) Export(in Request) (*Response, error) {
 	logging.Trace()
 
-	name := in.Args[0]
+	var options flags.TemplateExportOptions
+	utils.LoadObject(in.Options, &options)
 
-	res, err := r.resource.GetByName(name)
-	if err != nil {
-		return nil, err
+	if options.Tag != "" {
+		names, err := taggedAssetNames(r.client, dependencyTemplate, options.Tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(names) == 0 {
+			return &Response{
+				Text: fmt.Sprintf("No templates have the tag `%s`, nothing was exported", options.Tag),
+			}, nil
+		}
+
+		assets := map[string]interface{}{}
+
+		for _, name := range names {
+			_, res, err := r.exportTemplate(in, name, options.Expand)
+			if err != nil {
+				return nil, err
+			}
+			for fn, value := range res {
+				assets[fn] = value
+			}
+		}
+
+		if err := exportAssets(in, "", assets); err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Text: fmt.Sprintf("Successfully exported %v template(s) with the tag `%s`", len(names), options.Tag),
+		}, nil
 	}
 
-	exported, err := r.resource.Export(res.Id)
+	exported, assets, err := r.exportTemplate(in, in.Args[0], options.Expand)
 	if err != nil {
 		return nil, err
 	}
 
-	fn := fmt.Sprintf("%s.template.json", exported.Name)
-
-	if err := exportAssetFromRequest(in, exported, fn); err != nil {
+	if err := exportAssets(in, "", assets); err != nil {
 		return nil, err
 	}
 
@@ -303,6 +354,47 @@ func (r *TemplateRunner) Export(in Request) (*Response, error) {
 	}, nil
 }
 
+// exportTemplate returns the files written when exporting the named template.
+// The files are made canonical when the request asks for canonical output.
+func (r *TemplateRunner) exportTemplate(in Request, name string, expand bool) (*services.Template, map[string]interface{}, error) {
+	logging.Trace()
+
+	res, err := r.resource.GetByName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exported, err := r.resource.Export(res.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fn := fmt.Sprintf("%s.template.json", exported.Name)
+
+	if !expand {
+		value, err := canonicalAssetFromRequest(in, "template", exported)
+		if err != nil {
+			return nil, nil, err
+		}
+		return exported, map[string]interface{}{fn: value}, nil
+	}
+
+	expanded, err := newExpandedAsset(in, "template", exported, fn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The template body is written with the extension of the template
+	// type so it can be reviewed and edited as a plain text file
+	ext := "textfsm"
+	if exported.Type == "jinja2" {
+		ext = "j2"
+	}
+	expanded.move("template", fmt.Sprintf("%s.%s", exported.Name, ext))
+
+	return exported, expanded.assets(), nil
+}
+
 /*
 *******************************************************************************
 Dumper interface
@@ -313,6 +405,14 @@ Dumper interface
 func (r *TemplateRunner) Dump(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.TemplateDumpOptions
+	utils.LoadObject(in.Options, &options)
+
+	selector, err := newTagSelector(r.client, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := r.resource.GetAll()
 	if err != nil {
 		return nil, err
@@ -321,13 +421,20 @@ func (r *TemplateRunner) Dump(in Request) (*Response, error) {
 	var assets = map[string]interface{}{}
 
 	for _, ele := range res {
-		if !strings.HasPrefix(ele.Name, "@") {
+		if strings.HasPrefix(ele.Name, "@") {
+			continue
+		}
+		matched, err := selector.Match(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
 			key := fmt.Sprintf("%s.template.json", ele.Name)
 			assets[key] = ele
 		}
 	}
 
-	if err := dumpAssets(in, assets); err != nil {
+	if err := dumpAssets(in, "template", assets); err != nil {
 		return nil, err
 	}
 
@@ -401,6 +508,211 @@ func (r *TemplateRunner) Load(in Request) (*Response, error) {
 
 }
 
+/*
+*******************************************************************************
+Tester interface
+*******************************************************************************
+*/
+
+// Test implements the `test template <name|@file> --input <input>` command.
+// TextFSM templates parse the input through the platform and Jinja2
+// templates are rendered using the input as variables.  When `--expect` is
+// specified, the result is compared to the expected output and an error is
+// returned if they differ.
+func (r *TemplateRunner) Test(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.TemplateTestOptions)
+
+	name := in.Args[0]
+
+	var text string
+	var templateType = options.Type
+
+	if strings.HasPrefix(name, "@") {
+		b, err := readArgData(name)
+		if err != nil {
+			return nil, err
+		}
+		text = string(b)
+		if templateType == "" {
+			templateType = templateTypeFromPath(name[1:])
+		}
+	} else {
+		t, err := r.resource.GetByName(name)
+		if err != nil {
+			return nil, err
+		}
+		text = t.Template
+		templateType = t.Type
+	}
+
+	var result any
+
+	switch templateType {
+	case "textfsm":
+		input, err := readArgData(options.Input)
+		if err != nil {
+			return nil, err
+		}
+		res, err := r.service.Parse(text, string(input))
+		if err != nil {
+			return nil, err
+		}
+		result = utils.NormalizeValue(res)
+	case "jinja2":
+		vars, err := readArgVariables(options.Input)
+		if err != nil {
+			return nil, err
+		}
+		res, err := r.config_manager.Render(services.ConfigManagerJinja2Template{
+			Template:  text,
+			Variables: vars,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = res
+	default:
+		return nil, fmt.Errorf("unsupported template type `%s`, expected textfsm or jinja2", templateType)
+	}
+
+	actual, err := templateTestOutput(result)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]interface{}{
+		"template": name,
+		"type":     templateType,
+		"result":   result,
+	}
+
+	output := []string{actual}
+
+	if options.Expect == "" {
+		return &Response{
+			Text:   strings.Join(output, "\n"),
+			Object: object,
+		}, nil
+	}
+
+	b, err := readArgData(options.Expect)
+	if err != nil {
+		return nil, err
+	}
+
+	var expected string
+
+	if templateType == "jinja2" {
+		expected = string(b)
+	} else {
+		var value any
+		if err := utils.UnmarshalData(b, &value); err != nil {
+			return nil, err
+		}
+		expected, err = templateTestOutput(utils.NormalizeValue(value))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diff, err := utils.UnifiedDiff(
+		strings.TrimRight(expected, "\n")+"\n",
+		strings.TrimRight(actual, "\n")+"\n",
+		"expected", "actual",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	object["passed"] = diff == ""
+	object["diff"] = diff
+
+	if diff != "" {
+		output = append(output, diff)
+		return &Response{
+			Text:   strings.Join(output, "\n"),
+			Object: object,
+		}, fmt.Errorf("template `%s` result does not match the expected output", name)
+	}
+
+	output = append(output, "Result matches the expected output")
+
+	return &Response{
+		Text:   strings.Join(output, "\n"),
+		Object: object,
+	}, nil
+}
+
+/*
+*******************************************************************************
+Renamer interface
+*******************************************************************************
+*/
+
+// Rename implements the `rename template <old> <new>` command.  The template
+// is renamed in place and any workflow tasks that render the template by
+// name are updated to use the new name.
+func (r *TemplateRunner) Rename(in Request) (*Response, error) {
+	logging.Trace()
+
+	from, to := in.Args[0], in.Args[1]
+
+	if _, err := r.resource.GetByName(to); err == nil {
+		return nil, fmt.Errorf("template `%s` already exists", to)
+	}
+
+	current, err := r.resource.GetByName(from)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := services.NewTemplateService(r.client)
+	plan := newRenamePlan(dependencyTemplate, from, to)
+
+	before := *current
+	after := *current
+	after.Name = to
+
+	plan.add(dependencyTemplate, from, before, after,
+		func() error { _, err := svc.Update(after); return err },
+		func() error { _, err := svc.Update(before); return err },
+	)
+
+	workflowSvc := services.NewWorkflowService(r.client)
+
+	workflows, err := resources.NewWorkflowResource(workflowSvc).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := renameWorkflowReferences(workflowSvc, plan, workflows, ""); err != nil {
+		return nil, err
+	}
+
+	return renameResponse(in, plan)
+}
+
+/*
+*******************************************************************************
+Tagger interface
+*******************************************************************************
+*/
+
+// Tag implements the `tag template <name> --add <tags> --remove <tags>`
+// command
+func (r *TemplateRunner) Tag(in Request) (*Response, error) {
+	logging.Trace()
+
+	template, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return tagAsset(r.client, dependencyTemplate, template.Id, template.Name, in.Options.(*flags.AssetTagOptions))
+}
+
 /*
 *******************************************************************************
 Private functions
@@ -435,3 +747,30 @@ func (r TemplateRunner) importTemplate(in services.Template, replace bool) error
 
 	return nil
 }
+
+// templateTypeFromPath returns the template type based on the file
+// extension.  Files ending in .j2, .jinja or .jinja2 are considered Jinja2
+// templates, all other files are considered TextFSM templates.
+func templateTypeFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".j2", ".jinja", ".jinja2":
+		return "jinja2"
+	}
+	return "textfsm"
+}
+
+// templateTestOutput returns the string representation of a template test
+// result.  Rendered text is returned as is and structured results are
+// returned as indented JSON with sorted keys.
+func templateTestOutput(in any) (string, error) {
+	if s, ok := in.(string); ok {
+		return s, nil
+	}
+
+	b, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -5,11 +5,14 @@
 package runners
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/testlib"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -66,3 +69,53 @@ func TestImportTemplateNoReplace(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Contains(t, res.Text, "test-template")
 }
+
+func TestTemplateTestWithExpect(t *testing.T) {
+	runner := NewTemplateRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/template_builder/parseTemplate",
+		`[{"hostname": "rtr1", "version": "15.2"}]`,
+		200,
+	)
+
+	dir := t.TempDir()
+	template := filepath.Join(dir, "show_version.textfsm")
+	expect := filepath.Join(dir, "expected.yaml")
+	require.NoError(t, os.WriteFile(template, []byte("Value hostname (\\S+)\n"), 0644))
+	require.NoError(t, os.WriteFile(expect, []byte("- version: \"15.2\"\n  hostname: rtr1\n"), 0644))
+
+	res, err := runner.Test(Request{
+		Args: []string{"@" + template},
+		Options: &flags.TemplateTestOptions{
+			Input:  "rtr1 uptime is 1 day",
+			Expect: "@" + expect,
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "matches the expected output")
+
+	require.NoError(t, os.WriteFile(expect, []byte(`[{"hostname": "rtr2", "version": "15.2"}]`), 0644))
+
+	res, err = runner.Test(Request{
+		Args: []string{"@" + template},
+		Options: &flags.TemplateTestOptions{
+			Input:  "rtr1 uptime is 1 day",
+			Expect: "@" + expect,
+		},
+	})
+	assert.Error(t, err)
+	require.NotNil(t, res)
+	assert.Contains(t, res.Text, `+    "hostname": "rtr1",`)
+}
+
+func TestTemplateTypeFromPath(t *testing.T) {
+	assert.Equal(t, "jinja2", templateTypeFromPath("base.j2"))
+	assert.Equal(t, "jinja2", templateTypeFromPath("base.JINJA2"))
+	assert.Equal(t, "textfsm", templateTypeFromPath("show_version.textfsm"))
+	assert.Equal(t, "textfsm", templateTypeFromPath("show_version"))
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/itential/ipctl/internal/config"
 	"github.com/itential/ipctl/internal/flags"
 	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
+	"github.com/itential/ipctl/pkg/jst"
 	"github.com/itential/ipctl/pkg/resources"
 	"github.com/itential/ipctl/pkg/services"
 )
@@ -39,6 +41,11 @@ func (r *TransformationRunner) Get(in Request) (*Response, error) {
 
 	options := in.Options.(*flags.TransformationGetOptions)
 
+	selector, err := newTagSelector(r.client, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := r.resource.GetAll()
 	if err != nil {
 		return nil, err
@@ -47,9 +54,14 @@ func (r *TransformationRunner) Get(in Request) (*Response, error) {
 	var transformations []services.Transformation
 
 	for _, ele := range res {
-		if strings.HasPrefix(ele.Name, "@") && options.All {
-			transformations = append(transformations, ele)
-		} else if !strings.HasPrefix(ele.Name, "@") {
+		if strings.HasPrefix(ele.Name, "@") && !options.All {
+			continue
+		}
+		matched, err := selector.Match(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
 			transformations = append(transformations, ele)
 		}
 	}
@@ -158,6 +170,13 @@ func (r *TransformationRunner) Clear(in Request) (*Response, error) {
 func (r *TransformationRunner) Copy(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.TransformationCopyOptions
+	utils.LoadObject(in.Options, &options)
+
+	if options.Tag != "" {
+		return copyTagged(in, dependencyTransformation, options.Tag, r, r.config)
+	}
+
 	res, err := Copy(CopyRequest{Request: in, Type: "transformation"}, r)
 	if err != nil {
 		return nil, err
@@ -232,16 +251,17 @@ func (r *TransformationRunner) Import(in Request) (*Response, error) {
 
 	var res services.Transformation
 
-	if err := importUnmarshalFromRequest(in, &res); err != nil {
+	if err := importExpandedFromRequest(in, &res, assembleTransformation, "schema", "functions"); err != nil {
 		return nil, err
 	}
 
-	if err := r.importTransformation(res, common.Replace); err != nil {
+	imported, err := r.importTransformation(res, common.Replace)
+	if err != nil {
 		return nil, err
 	}
 
 	return &Response{
-		Text: fmt.Sprintf("Successfully imported transformation `%s` (%s)", res.Name, res.Id),
+		Text: fmt.Sprintf("Successfully imported transformation `%s` (%s)", imported.Name, imported.Id),
 	}, nil
 }
 
@@ -252,46 +272,312 @@ func (r *TransformationRunner) Import(in Request) (*Response, error) {
 func (r *TransformationRunner) Export(in Request) (*Response, error) {
 	logging.Trace()
 
-	name := in.Args[0]
+	var options flags.TransformationExportOptions
+	utils.LoadObject(in.Options, &options)
 
-	res, err := r.resource.GetByName(name)
+	if options.Tag != "" {
+		names, err := taggedAssetNames(r.client, dependencyTransformation, options.Tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(names) == 0 {
+			return &Response{
+				Text: fmt.Sprintf("No transformations have the tag `%s`, nothing was exported", options.Tag),
+			}, nil
+		}
+
+		assets := map[string]interface{}{}
+
+		for _, name := range names {
+			_, res, err := r.exportTransformation(in, name, options.Expand)
+			if err != nil {
+				return nil, err
+			}
+			for fn, value := range res {
+				assets[fn] = value
+			}
+		}
+
+		if err := exportAssets(in, "", assets); err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Text: fmt.Sprintf("Successfully exported %v transformation(s) with the tag `%s`", len(names), options.Tag),
+		}, nil
+	}
+
+	res, assets, err := r.exportTransformation(in, in.Args[0], options.Expand)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := exportAssets(in, "", assets); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully exported transformation `%s` (%s)", res.Name, res.Id),
+	}, nil
+}
+
+// exportTransformation returns the files written when exporting the named
+// transformation.  The files are made canonical when the request asks for
+// canonical output.
+func (r *TransformationRunner) exportTransformation(in Request, name string, expand bool) (*services.Transformation, map[string]interface{}, error) {
+	logging.Trace()
+
+	res, err := r.resource.GetByName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	fn := fmt.Sprintf("%s.transformation.json", name)
 
-	if err := exportAssetFromRequest(in, res, fn); err != nil {
+	if !expand {
+		value, err := canonicalAssetFromRequest(in, "transformation", res)
+		if err != nil {
+			return nil, nil, err
+		}
+		return res, map[string]interface{}{fn: value}, nil
+	}
+
+	expanded, err := expandTransformation(in, res, fn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, expanded.assets(), nil
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Renamer interface
+//
+
+// Rename implements the `rename transformation <old> <new>` command.
+// Workflows reference transformations by id so only the transformation
+// itself is updated.
+func (r *TransformationRunner) Rename(in Request) (*Response, error) {
+	logging.Trace()
+
+	from, to := in.Args[0], in.Args[1]
+
+	if _, err := r.resource.GetByName(to); err == nil {
+		return nil, fmt.Errorf("transformation `%s` already exists", to)
+	}
+
+	current, err := r.resource.GetByName(from)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := services.NewTransformationService(r.client)
+	plan := newRenamePlan(dependencyTransformation, from, to)
+
+	before := *current
+	after := *current
+	after.Name = to
+
+	plan.add(dependencyTransformation, from, before, after,
+		func() error { _, err := svc.Update(after); return err },
+		func() error { _, err := svc.Update(before); return err },
+	)
+
+	return renameResponse(in, plan)
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Runner interface
+//
+
+// Run implements the `run transformation <name|@file> --input <input>`
+// command.  The transformation is evaluated locally so it can be tested
+// without running a workflow.  When `--expect` is specified, the outgoing
+// values are compared to the expected result and an error is returned if
+// they differ.
+func (r *TransformationRunner) Run(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.TransformationRunOptions)
+
+	name := in.Args[0]
+
+	var transformation services.Transformation
+
+	if strings.HasPrefix(name, "@") {
+		b, err := readArgData(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := utils.UnmarshalData(b, &transformation); err != nil {
+			return nil, err
+		}
+	} else {
+		res, err := r.resource.GetByName(name)
+		if err != nil {
+			return nil, err
+		}
+		transformation = *res
+	}
+
+	incoming, err := readArgVariables(options.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	outgoing, err := jst.Run(transformation, incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := templateTestOutput(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]interface{}{
+		"transformation": name,
+		"result":         outgoing,
+	}
+
+	output := []string{actual}
+
+	if options.Expect == "" {
+		return &Response{
+			Text:   strings.Join(output, "\n"),
+			Object: object,
+		}, nil
+	}
+
+	b, err := readArgData(options.Expect)
+	if err != nil {
 		return nil, err
 	}
 
+	var value any
+	if err := utils.UnmarshalData(b, &value); err != nil {
+		return nil, err
+	}
+
+	expected, err := templateTestOutput(utils.NormalizeValue(value))
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := utils.UnifiedDiff(expected+"\n", actual+"\n", "expected", "actual")
+	if err != nil {
+		return nil, err
+	}
+
+	object["passed"] = diff == ""
+	object["diff"] = diff
+
+	if diff != "" {
+		output = append(output, diff)
+		return &Response{
+			Text:   strings.Join(output, "\n"),
+			Object: object,
+		}, fmt.Errorf("transformation `%s` result does not match the expected output", name)
+	}
+
+	output = append(output, "Result matches the expected output")
+
 	return &Response{
-		Text: fmt.Sprintf("Successfully exported transformation `%s` (%s)", res.Name, res.Id),
+		Text:   strings.Join(output, "\n"),
+		Object: object,
 	}, nil
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Tagger interface
+//
+
+// Tag implements the `tag transformation <name> --add <tags> --remove <tags>`
+// command
+func (r *TransformationRunner) Tag(in Request) (*Response, error) {
+	logging.Trace()
+
+	transformation, err := r.resource.GetByName(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return tagAsset(r.client, dependencyTransformation, transformation.Id, transformation.Name, in.Options.(*flags.AssetTagOptions))
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Private functions
 //
 
-func (r *TransformationRunner) importTransformation(in services.Transformation, replace bool) error {
+func (r *TransformationRunner) importTransformation(in services.Transformation, replace bool) (*services.Transformation, error) {
 	logging.Trace()
 
 	p, err := r.resource.GetByName(in.Name)
 	if err == nil {
 		if replace {
 			if err := r.resource.Delete(p.Id); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
-			return errors.New(fmt.Sprintf("transformation `%s` already exists, use `--replace` to overwrite", p.Name))
+			return nil, errors.New(fmt.Sprintf("transformation `%s` already exists, use `--replace` to overwrite", p.Name))
 		}
 	}
 
-	_, err = r.resource.Import(in)
+	// return the imported transformation since the server assigns the id
+	// when the document does not include one
+	return r.resource.Import(in)
+}
+
+// expandTransformation moves the incoming and outgoing schemas of the
+// transformation into a single schema file and writes each function to its
+// own file.
+func expandTransformation(in Request, t *services.Transformation, fn string) (*expandedAsset, error) {
+	logging.Trace()
+
+	expanded, err := newExpandedAsset(in, "transformation", t, fn)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	expanded.document["schema"] = map[string]interface{}{
+		"incoming": expanded.document["incoming"],
+		"outgoing": expanded.document["outgoing"],
+	}
+	delete(expanded.document, "incoming")
+	delete(expanded.document, "outgoing")
+
+	expanded.move("schema", fmt.Sprintf("%s.schema.json", t.Name))
+
+	expanded.split("functions", func(idx int, ele any) string {
+		name := fmt.Sprint(idx)
+		if m, ok := ele.(map[string]interface{}); ok {
+			if s, ok := m["name"].(string); ok && s != "" {
+				name = s
+			}
+		}
+		return fmt.Sprintf("%s.function.%s.json", t.Name, name)
+	})
+
+	return expanded, nil
+}
+
+// assembleTransformation restores the incoming and outgoing schemas from the
+// schema file of an expanded transformation.
+func assembleTransformation(document map[string]interface{}) error {
+	value, exists := document["schema"]
+	if !exists {
+		return nil
+	}
+
+	schema, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("transformation schema file must contain an object with incoming and outgoing fields")
 	}
 
-	return err
+	document["incoming"] = schema["incoming"]
+	document["outgoing"] = schema["outgoing"]
+	delete(document, "schema")
+
+	return nil
 }
@@ -0,0 +1,91 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/jst"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transformationRunFixture = "@testdata/transformations/hostname.transformation.json"
+
+func TestTransformationRun(t *testing.T) {
+	runner := NewTransformationRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	res, err := runner.Run(Request{
+		Args: []string{transformationRunFixture},
+		Options: &flags.TransformationRunOptions{
+			Input: `{"site": "ATL", "index": 7}`,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"hostname\": \"atl-7\"\n}", res.Text)
+}
+
+func TestTransformationRunExpect(t *testing.T) {
+	runner := NewTransformationRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	expect := filepath.Join(t.TempDir(), "expected.yaml")
+	require.NoError(t, os.WriteFile(expect, []byte("hostname: atl-7\n"), 0644))
+
+	res, err := runner.Run(Request{
+		Args: []string{transformationRunFixture},
+		Options: &flags.TransformationRunOptions{
+			Input:  `{"site": "ATL", "index": 7}`,
+			Expect: "@" + expect,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, res.Text, "Result matches the expected output")
+
+	res, err = runner.Run(Request{
+		Args: []string{transformationRunFixture},
+		Options: &flags.TransformationRunOptions{
+			Input:  `{"site": "ATL", "index": 8}`,
+			Expect: "@" + expect,
+		},
+	})
+
+	require.Error(t, err)
+	assert.EqualError(t, err, "transformation `@testdata/transformations/hostname.transformation.json` result does not match the expected output")
+	assert.Contains(t, res.Text, `-  "hostname": "atl-7"`)
+	assert.Contains(t, res.Text, `+  "hostname": "atl-8"`)
+}
+
+func TestTransformationRunInvalidInput(t *testing.T) {
+	runner := NewTransformationRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	_, err := runner.Run(Request{
+		Args: []string{transformationRunFixture},
+		Options: &flags.TransformationRunOptions{
+			Input: `{"site": "ATL", "index": 0}`,
+		},
+	})
+
+	var verr jst.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []string{"index must be greater than or equal to 1"}, verr.Problems)
+}
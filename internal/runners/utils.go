@@ -7,6 +7,7 @@ package runners
 import (
 	"context"
 	"errors"
+	"os"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/itential/ipctl/internal/profile"
 	"github.com/itential/ipctl/internal/utils"
 	"github.com/itential/ipctl/pkg/client"
+	"github.com/mitchellh/go-homedir"
 )
 
 // normalizeFilename will take a string argument for a filename and normalize
@@ -98,3 +100,45 @@ func NormalizePath(in Request) (string, error) {
 
 	return path, nil
 }
+
+// readArgData returns the data referenced by a command line value.  If the
+// value is prefixed with `@`, the remainder of the value is treated as a path
+// and the contents of the file are returned.  Otherwise the value itself is
+// returned.
+func readArgData(in string) ([]byte, error) {
+	logging.Trace()
+
+	if strings.HasPrefix(in, "@") {
+		path, err := homedir.Expand(in[1:])
+		if err != nil {
+			return nil, err
+		}
+		return os.ReadFile(path)
+	}
+
+	return []byte(in), nil
+}
+
+// readArgVariables loads a set of variables from a command line value.  The
+// value can either be an inline JSON object or a reference to a JSON or YAML
+// file using the `@` prefix.  An empty value returns an empty map.
+func readArgVariables(in string) (map[string]interface{}, error) {
+	logging.Trace()
+
+	vars := map[string]interface{}{}
+
+	if in == "" {
+		return vars, nil
+	}
+
+	data, err := readArgData(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.UnmarshalData(data, &vars); err != nil {
+		return nil, err
+	}
+
+	return utils.NormalizeMap(vars), nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itential/ipctl/pkg/services"
+)
+
+const (
+	workflowEdgeSuccess = "success"
+	workflowEdgeFailure = "failure"
+	workflowEdgeError   = "error"
+	workflowEdgeRevert  = "revert"
+)
+
+var workflowMermaidIdExpr = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// workflowDiagramFormats maps the supported diagram formats to the file
+// extension used when the diagram is exported.
+var workflowDiagramFormats = map[string]string{
+	"dot":       "dot",
+	"mermaid":   "mmd",
+	"svg-ready": "gv",
+}
+
+// renderWorkflowDiagram renders the workflow task graph in the requested
+// format.  The `dot` format is a plain Graphviz document, `svg-ready` adds
+// the colors and fonts needed to produce a presentable image using
+// `dot -Tsvg` and `mermaid` is suitable for embedding in markdown.
+func renderWorkflowDiagram(wf services.Workflow, format string) (string, error) {
+	switch format {
+	case "dot":
+		return workflowDot(wf, false), nil
+	case "svg-ready":
+		return workflowDot(wf, true), nil
+	case "mermaid":
+		return workflowMermaid(wf), nil
+	}
+	return "", fmt.Errorf("unsupported diagram format `%s`", format)
+}
+
+// workflowEdgeKind classifies a transition as a success, failure, error or
+// revert edge.
+func workflowEdgeKind(t workflowTransition) string {
+	if t.Type == workflowEdgeRevert {
+		return workflowEdgeRevert
+	}
+	switch t.State {
+	case workflowEdgeFailure, workflowEdgeError:
+		return t.State
+	}
+	return workflowEdgeSuccess
+}
+
+// workflowNodeLabel returns the label displayed for a task in a diagram.
+// Child job tasks are labeled with the name of the workflow they start.
+func workflowNodeLabel(key string, task map[string]interface{}) string {
+	switch key {
+	case workflowStartTask:
+		return "start"
+	case workflowEndTask:
+		return "end"
+	}
+
+	label := workflowTaskString(task, "summary")
+	if label == "" {
+		label = workflowTaskString(task, "name")
+	}
+	if label == "" {
+		label = key
+	}
+
+	if workflowTaskString(task, "name") == "childJob" {
+		if child := workflowChildName(task); child != "" {
+			return fmt.Sprintf("%s\nchild workflow: %s", label, child)
+		}
+		return fmt.Sprintf("%s\nchild workflow", label)
+	}
+
+	return label
+}
+
+func workflowTaskMap(wf services.Workflow, key string) map[string]interface{} {
+	task, _ := wf.Tasks[key].(map[string]interface{})
+	return task
+}
+
+func dotQuote(in string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(in) + `"`
+}
+
+func workflowDot(wf services.Workflow, styled bool) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(wf.Name))
+	b.WriteString("  rankdir=TB;\n")
+
+	if styled {
+		fmt.Fprintf(&b, "  label=%s;\n", dotQuote(wf.Name))
+		b.WriteString("  labelloc=t;\n")
+		b.WriteString("  bgcolor=\"white\";\n")
+		b.WriteString("  fontname=\"Helvetica\";\n")
+		b.WriteString("  node [fontname=\"Helvetica\", fontsize=11, style=\"rounded,filled\", fillcolor=\"#eef3fb\", color=\"#4a6fa5\"];\n")
+		b.WriteString("  edge [fontname=\"Helvetica\", fontsize=9];\n")
+	}
+
+	b.WriteString("  node [shape=box];\n")
+
+	for _, key := range workflowTaskKeys(wf) {
+		task := workflowTaskMap(wf, key)
+
+		attrs := []string{"label=" + dotQuote(workflowNodeLabel(key, task))}
+
+		switch {
+		case key == workflowStartTask || key == workflowEndTask:
+			attrs = append(attrs, "shape=circle")
+			if styled {
+				attrs = append(attrs, `fillcolor="#d9d9d9"`)
+			}
+		case workflowTaskString(task, "name") == "childJob":
+			attrs = append(attrs, "shape=box3d")
+			if styled {
+				attrs = append(attrs, `fillcolor="#fff2cc"`)
+			}
+		case workflowTaskString(task, "type") == "manual":
+			attrs = append(attrs, "shape=house")
+		}
+
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(key), strings.Join(attrs, ", "))
+	}
+
+	for _, ele := range workflowTransitions(wf) {
+		var attrs []string
+
+		switch workflowEdgeKind(ele) {
+		case workflowEdgeFailure:
+			attrs = append(attrs, `style=dashed`, `label="failure"`)
+			if styled {
+				attrs = append(attrs, `color="#e69138"`, `fontcolor="#e69138"`)
+			}
+		case workflowEdgeError:
+			attrs = append(attrs, `style=dashed`, `label="error"`)
+			if styled {
+				attrs = append(attrs, `color="#cc0000"`, `fontcolor="#cc0000"`)
+			}
+		case workflowEdgeRevert:
+			attrs = append(attrs, `style=dotted`, `label="revert"`, `constraint=false`)
+			if styled {
+				attrs = append(attrs, `color="#3d85c6"`, `fontcolor="#3d85c6"`)
+			}
+		default:
+			attrs = append(attrs, `style=solid`)
+			if styled {
+				attrs = append(attrs, `color="#38761d"`)
+			}
+		}
+
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(ele.From), dotQuote(ele.To), strings.Join(attrs, ", "))
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func mermaidId(key string) string {
+	return "t_" + workflowMermaidIdExpr.ReplaceAllString(key, "_")
+}
+
+func mermaidQuote(in string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>")
+	return `"` + r.Replace(in) + `"`
+}
+
+func workflowMermaid(wf services.Workflow) string {
+	var b strings.Builder
+
+	b.WriteString("flowchart TD\n")
+
+	for _, key := range workflowTaskKeys(wf) {
+		task := workflowTaskMap(wf, key)
+		label := mermaidQuote(workflowNodeLabel(key, task))
+
+		switch {
+		case key == workflowStartTask || key == workflowEndTask:
+			fmt.Fprintf(&b, "  %s((%s))\n", mermaidId(key), label)
+		case workflowTaskString(task, "name") == "childJob":
+			fmt.Fprintf(&b, "  %s[[%s]]\n", mermaidId(key), label)
+		default:
+			fmt.Fprintf(&b, "  %s[%s]\n", mermaidId(key), label)
+		}
+	}
+
+	var styles []string
+
+	for idx, ele := range workflowTransitions(wf) {
+		from, to := mermaidId(ele.From), mermaidId(ele.To)
+
+		switch workflowEdgeKind(ele) {
+		case workflowEdgeFailure:
+			fmt.Fprintf(&b, "  %s -. failure .-> %s\n", from, to)
+			styles = append(styles, fmt.Sprintf("  linkStyle %v stroke:#e69138", idx))
+		case workflowEdgeError:
+			fmt.Fprintf(&b, "  %s -. error .-> %s\n", from, to)
+			styles = append(styles, fmt.Sprintf("  linkStyle %v stroke:#cc0000", idx))
+		case workflowEdgeRevert:
+			fmt.Fprintf(&b, "  %s == revert ==> %s\n", from, to)
+			styles = append(styles, fmt.Sprintf("  linkStyle %v stroke:#3d85c6", idx))
+		default:
+			fmt.Fprintf(&b, "  %s --> %s\n", from, to)
+		}
+	}
+
+	for _, ele := range styles {
+		b.WriteString(ele + "\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,499 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package runners
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/itential/ipctl/internal/utils"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+const (
+	workflowStartTask = "workflow_start"
+	workflowEndTask   = "workflow_end"
+
+	workflowLintError   = "error"
+	workflowLintWarning = "warning"
+	workflowLintInfo    = "info"
+	workflowLintOff     = "off"
+)
+
+// workflowBuiltinApps are the applications that provide the built-in
+// workflow engine tasks.  Tasks from these apps are not checked against the
+// methods available on the server.
+var workflowBuiltinApps = map[string]bool{
+	"WorkFlowEngine": true,
+	"WorkflowEngine": true,
+}
+
+var workflowJobVarExpr = regexp.MustCompile(`\$var\.job\.([A-Za-z0-9_]+)`)
+
+// workflowLintRule describes a single check performed by the workflow
+// linter along with its default severity.
+type workflowLintRule struct {
+	Id          string
+	Name        string
+	Severity    string
+	Description string
+}
+
+var workflowLintRules = []workflowLintRule{
+	{"WF001", "unreachable-task", workflowLintError, "Task can not be reached from workflow_start"},
+	{"WF002", "no-path-to-end", workflowLintError, "Task has no path that reaches workflow_end"},
+	{"WF003", "missing-transition-target", workflowLintError, "Transition references a task that does not exist"},
+	{"WF004", "missing-error-transition", workflowLintWarning, "Task does not define an error transition"},
+	{"WF005", "unknown-app", workflowLintError, "Task references an application that does not exist on the server"},
+	{"WF006", "unknown-method", workflowLintError, "Task references a method that does not exist on the server"},
+	{"WF007", "missing-child-workflow", workflowLintError, "Child job task references a workflow that does not exist on the server"},
+	{"WF008", "undefined-variable", workflowLintWarning, "Task references a job variable that is never set"},
+}
+
+// workflowLintFinding is a single problem reported by the workflow linter.
+type workflowLintFinding struct {
+	Rule     string `json:"rule"`
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+	Task     string `json:"task,omitempty"`
+	Message  string `json:"message"`
+}
+
+// workflowLintConfig is the structure of the file passed to `--lint-config`.
+// Rules are keyed by either the rule id or the rule name and map to a
+// severity or `off` to disable the rule.  A value of `false` is accepted as
+// `off` since YAML treats an unquoted off as a boolean.
+type workflowLintConfig struct {
+	Rules map[string]interface{} `json:"rules" yaml:"rules"`
+}
+
+// workflowTransition is a single edge in the workflow task graph.
+type workflowTransition struct {
+	From  string
+	To    string
+	State string
+	Type  string
+}
+
+// workflowLinter performs static checks against a workflow document.  When
+// methods or workflows is nil, the checks that require the server are
+// skipped.
+type workflowLinter struct {
+	severities map[string]string
+	methods    map[string]map[string]bool
+	workflows  map[string]bool
+}
+
+// newWorkflowLinter returns a linter with the default rule severities
+// updated by the optional configuration.
+func newWorkflowLinter(cfg *workflowLintConfig) (*workflowLinter, error) {
+	l := &workflowLinter{severities: map[string]string{}}
+
+	for _, ele := range workflowLintRules {
+		l.severities[ele.Id] = ele.Severity
+	}
+
+	if cfg == nil {
+		return l, nil
+	}
+
+	for key, value := range cfg.Rules {
+		rule := findWorkflowLintRule(key)
+		if rule == nil {
+			return nil, fmt.Errorf("unknown lint rule `%s`", key)
+		}
+		severity := fmt.Sprint(value)
+		if value == false {
+			severity = workflowLintOff
+		}
+		switch severity {
+		case workflowLintError, workflowLintWarning, workflowLintInfo, workflowLintOff:
+			l.severities[rule.Id] = severity
+		default:
+			return nil, fmt.Errorf("invalid severity `%s` for lint rule `%s`", severity, key)
+		}
+	}
+
+	return l, nil
+}
+
+// loadWorkflowLintConfig parses a lint configuration document in either JSON
+// or YAML format.
+func loadWorkflowLintConfig(b []byte) (*workflowLintConfig, error) {
+	var cfg workflowLintConfig
+	if err := utils.UnmarshalData(b, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func findWorkflowLintRule(key string) *workflowLintRule {
+	for i, ele := range workflowLintRules {
+		if strings.EqualFold(ele.Id, key) || ele.Name == key {
+			return &workflowLintRules[i]
+		}
+	}
+	return nil
+}
+
+// Lint runs all enabled rules against the workflow and returns the findings
+// sorted by rule and task.
+func (l *workflowLinter) Lint(wf services.Workflow) []workflowLintFinding {
+	var findings []workflowLintFinding
+
+	report := func(id, task, format string, args ...any) {
+		severity := l.severities[id]
+		if severity == workflowLintOff {
+			return
+		}
+		findings = append(findings, workflowLintFinding{
+			Rule:     id,
+			Name:     findWorkflowLintRule(id).Name,
+			Severity: severity,
+			Task:     task,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	transitions := workflowTransitions(wf)
+
+	forward := map[string][]string{}
+	reverse := map[string][]string{}
+	errored := map[string]bool{}
+
+	for _, ele := range transitions {
+		for _, name := range []string{ele.From, ele.To} {
+			if _, exists := wf.Tasks[name]; !exists {
+				report("WF003", ele.From, "transition from `%s` to `%s` references missing task `%s`", ele.From, ele.To, name)
+			}
+		}
+		forward[ele.From] = append(forward[ele.From], ele.To)
+		reverse[ele.To] = append(reverse[ele.To], ele.From)
+		if ele.State == "error" {
+			errored[ele.From] = true
+		}
+	}
+
+	reachable := walkWorkflowGraph(workflowStartTask, forward)
+	finishes := walkWorkflowGraph(workflowEndTask, reverse)
+
+	set := workflowSetVariables(wf)
+
+	for _, key := range workflowTaskKeys(wf) {
+		task, _ := wf.Tasks[key].(map[string]interface{})
+
+		if !reachable[key] {
+			report("WF001", key, "task `%s` can not be reached from %s", workflowTaskLabel(key, task), workflowStartTask)
+		} else if !finishes[key] {
+			report("WF002", key, "task `%s` has no path to %s", workflowTaskLabel(key, task), workflowEndTask)
+		}
+
+		if key == workflowStartTask || key == workflowEndTask {
+			continue
+		}
+
+		app := workflowTaskString(task, "app")
+		method := workflowTaskString(task, "name")
+
+		if workflowTaskString(task, "type") == "automatic" && !errored[key] {
+			report("WF004", key, "task `%s` does not have an error transition", workflowTaskLabel(key, task))
+		}
+
+		if l.methods != nil && app != "" && !workflowBuiltinApps[app] {
+			if methods, exists := l.methods[app]; !exists {
+				report("WF005", key, "application `%s` used by task `%s` does not exist", app, workflowTaskLabel(key, task))
+			} else if !methods[method] {
+				report("WF006", key, "method `%s.%s` used by task `%s` does not exist", app, method, workflowTaskLabel(key, task))
+			}
+		}
+
+		if l.workflows != nil && method == "childJob" {
+			if child := workflowChildName(task); child != "" && !l.workflows[child] {
+				report("WF007", key, "child workflow `%s` does not exist", child)
+			}
+		}
+
+		var refs []string
+		collectWorkflowJobReferences(workflowTaskIncoming(task), &refs)
+		for _, ref := range uniqueSorted(refs) {
+			if !set[ref] {
+				report("WF008", key, "task `%s` references job variable `%s` which is never set", workflowTaskLabel(key, task), ref)
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Rule != findings[j].Rule {
+			return findings[i].Rule < findings[j].Rule
+		}
+		return findings[i].Task < findings[j].Task
+	})
+
+	return findings
+}
+
+// workflowTransitions flattens the transitions map of a workflow into a list
+// of edges sorted by source and target task.
+func workflowTransitions(wf services.Workflow) []workflowTransition {
+	var res []workflowTransition
+
+	for from, value := range wf.Transitions {
+		targets, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for to, props := range targets {
+			t := workflowTransition{From: from, To: to}
+			if m, ok := props.(map[string]interface{}); ok {
+				t.State, _ = m["state"].(string)
+				t.Type, _ = m["type"].(string)
+			}
+			res = append(res, t)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].From != res[j].From {
+			return res[i].From < res[j].From
+		}
+		return res[i].To < res[j].To
+	})
+
+	return res
+}
+
+// workflowTaskKeys returns the task keys of the workflow in sorted order.
+func workflowTaskKeys(wf services.Workflow) []string {
+	var keys []string
+	for key := range wf.Tasks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func workflowTaskString(task map[string]interface{}, key string) string {
+	if task == nil {
+		return ""
+	}
+	value, _ := task[key].(string)
+	return value
+}
+
+// workflowTaskLabel returns a human readable label for a task using its
+// summary when one is available.
+func workflowTaskLabel(key string, task map[string]interface{}) string {
+	if summary := workflowTaskString(task, "summary"); summary != "" {
+		return fmt.Sprintf("%s (%s)", summary, key)
+	}
+	return key
+}
+
+func workflowTaskVariables(task map[string]interface{}, key string) map[string]interface{} {
+	if task == nil {
+		return nil
+	}
+	variables, _ := task["variables"].(map[string]interface{})
+	if variables == nil {
+		return nil
+	}
+	res, _ := variables[key].(map[string]interface{})
+	return res
+}
+
+func workflowTaskIncoming(task map[string]interface{}) map[string]interface{} {
+	return workflowTaskVariables(task, "incoming")
+}
+
+// workflowChildName returns the name of the workflow started by a childJob
+// task or an empty string if the name is not static.
+func workflowChildName(task map[string]interface{}) string {
+	incoming := workflowTaskIncoming(task)
+	if incoming == nil {
+		return ""
+	}
+	name, _ := incoming["workflow"].(string)
+	if strings.HasPrefix(name, "$var.") {
+		return ""
+	}
+	return name
+}
+
+// walkWorkflowGraph returns the set of tasks reachable from start.
+func walkWorkflowGraph(start string, edges map[string][]string) map[string]bool {
+	seen := map[string]bool{start: true}
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[current] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return seen
+}
+
+// workflowSetVariables returns the set of job variables that are defined by
+// the workflow input schema or assigned by a task.
+func workflowSetVariables(wf services.Workflow) map[string]bool {
+	set := map[string]bool{"_id": true, "initiator": true}
+
+	if props, ok := wf.InputSchema["properties"].(map[string]interface{}); ok {
+		for key := range props {
+			set[key] = true
+		}
+	}
+
+	for _, value := range wf.Tasks {
+		task, _ := value.(map[string]interface{})
+
+		for _, v := range workflowTaskVariables(task, "outgoing") {
+			if s, ok := v.(string); ok {
+				for _, m := range workflowJobVarExpr.FindAllStringSubmatch(s, -1) {
+					set[m[1]] = true
+				}
+			}
+		}
+
+		if workflowTaskString(task, "name") == "newVariable" {
+			if name, ok := workflowTaskIncoming(task)["name"].(string); ok {
+				set[name] = true
+			}
+		}
+	}
+
+	return set
+}
+
+// collectWorkflowJobReferences walks a task value and collects the names of
+// all job variables it references, either as `$var.job.<name>` strings or as
+// `{"task": "job", "variable": "<name>"}` objects.
+func collectWorkflowJobReferences(value interface{}, refs *[]string) {
+	switch v := value.(type) {
+	case string:
+		for _, m := range workflowJobVarExpr.FindAllStringSubmatch(v, -1) {
+			*refs = append(*refs, m[1])
+		}
+	case map[string]interface{}:
+		if v["task"] == "job" {
+			if name, ok := v["variable"].(string); ok && name != "" {
+				*refs = append(*refs, strings.SplitN(name, ".", 2)[0])
+			}
+		}
+		for _, ele := range v {
+			collectWorkflowJobReferences(ele, refs)
+		}
+	case []interface{}:
+		for _, ele := range v {
+			collectWorkflowJobReferences(ele, refs)
+		}
+	}
+}
+
+func uniqueSorted(in []string) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, ele := range in {
+		if !seen[ele] {
+			seen[ele] = true
+			res = append(res, ele)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// workflowLintText renders the findings as plain text and returns the
+// number of error severity findings.
+func workflowLintText(name string, findings []workflowLintFinding) (string, int) {
+	var lines []string
+	var errs, warnings int
+
+	for _, ele := range findings {
+		switch ele.Severity {
+		case workflowLintError:
+			errs++
+		case workflowLintWarning:
+			warnings++
+		}
+		lines = append(lines, fmt.Sprintf("%-7s  %s  %-25s  %s", strings.ToUpper(ele.Severity), ele.Rule, ele.Name, ele.Message))
+	}
+
+	if len(findings) == 0 {
+		lines = append(lines, fmt.Sprintf("Workflow `%s` has no lint findings", name))
+	} else {
+		lines = append(lines, fmt.Sprintf("\nWorkflow `%s` has %v error(s) and %v warning(s)", name, errs, warnings))
+	}
+
+	return strings.Join(lines, "\n"), errs
+}
+
+// workflowLintSarif renders the findings as a SARIF 2.1.0 log document.
+func workflowLintSarif(uri string, findings []workflowLintFinding) ([]byte, error) {
+	var rules []map[string]interface{}
+	for _, ele := range workflowLintRules {
+		rules = append(rules, map[string]interface{}{
+			"id":               ele.Id,
+			"name":             ele.Name,
+			"shortDescription": map[string]string{"text": ele.Description},
+			"defaultConfiguration": map[string]string{
+				"level": workflowLintSarifLevel(ele.Severity),
+			},
+		})
+	}
+
+	results := []map[string]interface{}{}
+	for _, ele := range findings {
+		location := map[string]interface{}{
+			"physicalLocation": map[string]interface{}{
+				"artifactLocation": map[string]string{"uri": uri},
+			},
+		}
+		if ele.Task != "" {
+			location["logicalLocations"] = []map[string]string{
+				{"name": ele.Task, "kind": "task"},
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"ruleId":    ele.Rule,
+			"level":     workflowLintSarifLevel(ele.Severity),
+			"message":   map[string]string{"text": ele.Message},
+			"locations": []interface{}{location},
+		})
+	}
+
+	doc := map[string]interface{}{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []interface{}{
+			map[string]interface{}{
+				"tool": map[string]interface{}{
+					"driver": map[string]interface{}{
+						"name":  "ipctl",
+						"rules": rules,
+					},
+				},
+				"results": results,
+			},
+		},
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func workflowLintSarifLevel(severity string) string {
+	if severity == workflowLintInfo {
+		return "note"
+	}
+	return severity
+}
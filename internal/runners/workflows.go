@@ -5,6 +5,7 @@
 package runners
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -22,12 +23,14 @@ import (
 type WorkflowRunner struct {
 	BaseRunner
 	resource resources.WorkflowResourcer
+	methods  *services.MethodService
 }
 
 func NewWorkflowRunner(c client.Client, cfg config.Provider) *WorkflowRunner {
 	return &WorkflowRunner{
 		BaseRunner: NewBaseRunner(c, cfg),
 		resource:   resources.NewWorkflowResource(services.NewWorkflowService(c)),
+		methods:    services.NewMethodService(c),
 	}
 }
 
@@ -42,6 +45,11 @@ func (r *WorkflowRunner) Get(in Request) (*Response, error) {
 	var options flags.WorkflowGetOptions
 	utils.LoadObject(in.Options, &options)
 
+	selector, err := newTagSelector(r.client, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := r.resource.GetAll()
 	if err != nil {
 		return nil, err
@@ -50,9 +58,14 @@ func (r *WorkflowRunner) Get(in Request) (*Response, error) {
 	var workflows []services.Workflow
 
 	for _, ele := range res {
-		if strings.HasPrefix(ele.Name, "@") && options.All {
-			workflows = append(workflows, ele)
-		} else if !strings.HasPrefix(ele.Name, "@") {
+		if strings.HasPrefix(ele.Name, "@") && !options.All {
+			continue
+		}
+		matched, err := selector.Match(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
 			workflows = append(workflows, ele)
 		}
 	}
@@ -184,6 +197,20 @@ func (r *WorkflowRunner) Edit(in Request) (*Response, error) {
 func (r *WorkflowRunner) Copy(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.WorkflowCopyOptions
+	utils.LoadObject(in.Options, &options)
+
+	if options.Tag != "" {
+		if options.WithDependencies {
+			return nil, errors.New("--tag cannot be used with --with-dependencies")
+		}
+		return copyTagged(in, dependencyWorkflow, options.Tag, r, r.config)
+	}
+
+	if options.WithDependencies {
+		return copyDependencies(in, dependencyWorkflow, r.config)
+	}
+
 	res, err := Copy(CopyRequest{Request: in, Type: "workflow"}, r)
 	if err != nil {
 		return nil, err
@@ -278,22 +305,106 @@ func (r *WorkflowRunner) Import(in Request) (*Response, error) {
 func (r *WorkflowRunner) Export(in Request) (*Response, error) {
 	logging.Trace()
 
-	name := in.Args[0]
+	var options flags.WorkflowExportOptions
+	utils.LoadObject(in.Options, &options)
+
+	if options.Tag != "" {
+		return r.exportTagged(in, options)
+	}
+
+	workflow, _, err := r.readWorkflowArg(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if options.WithDependencies {
+		if options.Format != "" && options.Format != "json" {
+			return nil, errors.New("--with-dependencies can only be used with the json format")
+		}
+
+		root := newDependencyResolver(r.client).ResolveWorkflow(*workflow)
+
+		manifest, err := exportDependencies(in, root)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Text:   fmt.Sprintf("Successfully exported workflow `%s` with %v dependent asset(s)", workflow.Name, len(manifest.Assets)-1),
+			Object: manifest,
+		}, nil
+	}
+
+	if options.Format == "" || options.Format == "json" {
+		fn := fmt.Sprintf("%s.workflow.json", workflow.Name)
+
+		if err := exportAssetFromRequest(in, "workflow", workflow, fn); err != nil {
+			return nil, err
+		}
+
+		return &Response{
+			Text:   fmt.Sprintf("Successfully exported workflow `%s`", workflow.Name),
+			Object: workflow,
+		}, nil
+	}
 
-	workflow, err := r.resource.Export(name)
+	diagram, err := renderWorkflowDiagram(*workflow, options.Format)
 	if err != nil {
 		return nil, err
 	}
 
-	fn := fmt.Sprintf("%s.workflow.json", name)
+	fn := fmt.Sprintf("%s.workflow.%s", workflow.Name, workflowDiagramFormats[options.Format])
 
-	if err := exportAssetFromRequest(in, workflow, fn); err != nil {
+	if err := exportAssetFromRequest(in, "workflow", []byte(diagram), fn); err != nil {
 		return nil, err
 	}
 
 	return &Response{
-		Text:   fmt.Sprintf("Successfully exported workflow `%s`", workflow.Name),
-		Object: workflow,
+		Text:   fmt.Sprintf("Successfully exported workflow `%s` as %s to `%s`", workflow.Name, options.Format, fn),
+		Object: diagram,
+	}, nil
+}
+
+// exportTagged exports every workflow with the tag in a single operation so
+// a repository export results in a single commit.
+func (r *WorkflowRunner) exportTagged(in Request, options flags.WorkflowExportOptions) (*Response, error) {
+	logging.Trace()
+
+	if options.WithDependencies {
+		return nil, errors.New("--tag cannot be used with --with-dependencies")
+	}
+
+	if options.Format != "" && options.Format != "json" {
+		return nil, errors.New("--tag can only be used with the json format")
+	}
+
+	names, err := taggedAssetNames(r.client, dependencyWorkflow, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return &Response{
+			Text: fmt.Sprintf("No workflows have the tag `%s`, nothing was exported", options.Tag),
+		}, nil
+	}
+
+	assets := map[string]interface{}{}
+
+	for _, name := range names {
+		workflow, err := r.resource.Export(name)
+		if err != nil {
+			return nil, err
+		}
+		assets[fmt.Sprintf("%s.workflow.json", workflow.Name)] = workflow
+	}
+
+	if err := exportAssets(in, "workflow", assets); err != nil {
+		return nil, err
+	}
+
+	return &Response{
+		Text: fmt.Sprintf("Successfully exported %v workflow(s) with the tag `%s`", len(assets), options.Tag),
 	}, nil
 }
 
@@ -305,6 +416,14 @@ func (r *WorkflowRunner) Export(in Request) (*Response, error) {
 func (r *WorkflowRunner) Dump(in Request) (*Response, error) {
 	logging.Trace()
 
+	var options flags.WorkflowDumpOptions
+	utils.LoadObject(in.Options, &options)
+
+	selector, err := newTagSelector(r.client, options.Tag)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := r.resource.GetAll()
 	if err != nil {
 		return nil, err
@@ -313,13 +432,20 @@ func (r *WorkflowRunner) Dump(in Request) (*Response, error) {
 	var assets = map[string]interface{}{}
 
 	for _, ele := range res {
-		if !strings.HasPrefix(ele.Name, "@") {
+		if strings.HasPrefix(ele.Name, "@") {
+			continue
+		}
+		matched, err := selector.Match(ele.Id)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
 			key := fmt.Sprintf("%s.workflow.json", ele.Name)
 			assets[key] = ele
 		}
 	}
 
-	if err := dumpAssets(in, assets); err != nil {
+	if err := dumpAssets(in, "workflow", assets); err != nil {
 		return nil, err
 	}
 
@@ -328,6 +454,23 @@ func (r *WorkflowRunner) Dump(in Request) (*Response, error) {
 	}, nil
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Tagger Interface
+//
+
+// Tag implements the `tag workflow <name> --add <tags> --remove <tags>`
+// command
+func (r *WorkflowRunner) Tag(in Request) (*Response, error) {
+	logging.Trace()
+
+	workflow, err := r.resource.Get(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return tagAsset(r.client, dependencyWorkflow, workflow.Id, workflow.Name, in.Options.(*flags.AssetTagOptions))
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Loader Interface
 //
@@ -375,6 +518,194 @@ func (r *WorkflowRunner) Load(in Request) (*Response, error) {
 	}, nil
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Linter Interface
+//
+
+// Lint implements the `lint workflow <name|@file>` command
+func (r *WorkflowRunner) Lint(in Request) (*Response, error) {
+	logging.Trace()
+
+	options := in.Options.(*flags.WorkflowLintOptions)
+
+	var cfg *workflowLintConfig
+
+	if options.LintConfig != "" {
+		b, err := readArgData("@" + options.LintConfig)
+		if err != nil {
+			return nil, err
+		}
+		cfg, err = loadWorkflowLintConfig(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	linter, err := newWorkflowLinter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, uri, err := r.readWorkflowArg(in.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.Offline {
+		methods, err := r.methods.GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		linter.methods = map[string]map[string]bool{}
+		for _, ele := range methods {
+			if linter.methods[ele.Provenance] == nil {
+				linter.methods[ele.Provenance] = map[string]bool{}
+			}
+			linter.methods[ele.Provenance][ele.Name] = true
+		}
+
+		workflows, err := r.resource.GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		linter.workflows = map[string]bool{}
+		for _, ele := range workflows {
+			linter.workflows[ele.Name] = true
+		}
+	}
+
+	findings := linter.Lint(*workflow)
+
+	text, failed := workflowLintText(workflow.Name, findings)
+
+	switch options.Format {
+	case "text":
+	case "json":
+		b, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		text = string(b)
+	case "sarif":
+		b, err := workflowLintSarif(uri, findings)
+		if err != nil {
+			return nil, err
+		}
+		text = string(b)
+	default:
+		return nil, fmt.Errorf("unsupported lint format `%s`", options.Format)
+	}
+
+	res := &Response{
+		Text:   text,
+		Object: findings,
+	}
+
+	if failed > 0 {
+		return res, fmt.Errorf("workflow `%s` has %v lint error(s)", workflow.Name, failed)
+	}
+
+	return res, nil
+}
+
+//////////////////////////////////////////////////////////////////////////////
+// Renamer Interface
+//
+
+// Rename implements the `rename workflow <old> <new>` command.  The workflow
+// is renamed in place along with any workflows that start it as a child job
+// and any automations that trigger it.  Prebuilts that include the workflow
+// cannot be updated and are reported as warnings.
+func (r *WorkflowRunner) Rename(in Request) (*Response, error) {
+	logging.Trace()
+
+	from, to := in.Args[0], in.Args[1]
+
+	workflows, err := r.resource.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var current *services.Workflow
+
+	for idx, ele := range workflows {
+		switch ele.Name {
+		case to:
+			return nil, fmt.Errorf("workflow `%s` already exists", to)
+		case from:
+			current = &workflows[idx]
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("workflow `%s` not found", from)
+	}
+
+	svc := services.NewWorkflowService(r.client)
+	plan := newRenamePlan(dependencyWorkflow, from, to)
+
+	before := *current
+
+	after, err := cloneWorkflow(before)
+	if err != nil {
+		return nil, err
+	}
+	after.Name = to
+
+	// a workflow that starts itself as a child job must be updated as well
+	renameTaskReferences(&after, dependencyWorkflow, from, to)
+
+	plan.add(dependencyWorkflow, from, before, after,
+		func() error { _, err := svc.Update(after); return err },
+		func() error { _, err := svc.Update(before); return err },
+	)
+
+	if err := renameWorkflowReferences(svc, plan, workflows, from); err != nil {
+		return nil, err
+	}
+
+	automationSvc := services.NewAutomationService(r.client)
+
+	automations, err := resources.NewAutomationResource(automationSvc).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ele := range automations {
+		if ele.ComponentType != "workflows" || ele.ComponentName != from {
+			continue
+		}
+
+		before := *ele
+		after := *ele
+		after.ComponentName = to
+
+		plan.add(dependencyAutomation, ele.Name, before, after,
+			func() error { _, err := automationSvc.Update(after); return err },
+			func() error { _, err := automationSvc.Update(before); return err },
+		)
+	}
+
+	prebuilts, err := services.NewPrebuiltService(r.client).GetAll()
+	if err != nil {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("unable to check prebuilts for workflow `%s`: %s", from, err))
+	}
+
+	for _, p := range prebuilts {
+		for _, c := range p.Components {
+			if c.Name == from && strings.HasPrefix(c.Type, "workflow") {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+					"prebuilt `%s` includes workflow `%s` and must be updated manually", p.Name, from,
+				))
+			}
+		}
+	}
+
+	return renameResponse(in, plan)
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Private functions
 //
@@ -404,3 +735,32 @@ func (r *WorkflowRunner) importWorkflow(in services.Workflow, replace bool) erro
 
 	return nil
 }
+
+// readWorkflowArg returns the workflow identified by the command argument
+// along with a URI describing where it was loaded from.  Arguments that
+// start with `@` are read from the local file system, otherwise the workflow
+// is exported from the server.
+func (r *WorkflowRunner) readWorkflowArg(arg string) (*services.Workflow, string, error) {
+	logging.Trace()
+
+	if strings.HasPrefix(arg, "@") {
+		b, err := readArgData(arg)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var workflow services.Workflow
+		if err := utils.UnmarshalData(b, &workflow); err != nil {
+			return nil, "", err
+		}
+
+		return &workflow, arg[1:], nil
+	}
+
+	workflow, err := r.resource.Export(arg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return workflow, fmt.Sprintf("%s.workflow.json", arg), nil
+}
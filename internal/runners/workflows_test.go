@@ -5,11 +5,19 @@
 package runners
 
 import (
+	"encoding/json"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/itential/ipctl/internal/config"
+	"github.com/itential/ipctl/internal/flags"
+	"github.com/itential/ipctl/internal/profile"
 	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -36,3 +44,237 @@ func TestWorkflowDelete(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.NotEmpty(t, res.Text)
 }
+
+func lintRules(findings []workflowLintFinding) []string {
+	var res []string
+	for _, ele := range findings {
+		res = append(res, ele.Rule+":"+ele.Task)
+	}
+	return res
+}
+
+func TestWorkflowLintOffline(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	res, err := runner.Lint(Request{
+		Args:    []string{"@testdata/workflows/lint.workflow.json"},
+		Options: &flags.WorkflowLintOptions{Format: "text", Offline: true},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "4 lint error(s)")
+	require.NotNil(t, res)
+
+	assert.Equal(t, []string{
+		"WF001:b2",
+		"WF002:c3",
+		"WF002:e1",
+		"WF003:workflow_start",
+		"WF004:b2",
+		"WF004:e1",
+		"WF008:a1",
+	}, lintRules(res.Object.([]workflowLintFinding)))
+
+	assert.Contains(t, res.Text, "job variable `device` which is never set")
+	assert.Contains(t, res.Text, "has 4 error(s) and 3 warning(s)")
+}
+
+func TestWorkflowLintOnline(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/authorization/methods",
+		`{"results": [{"provenance": "AppA", "name": "otherThing"}], "total": 1}`,
+		0,
+	)
+
+	testlib.AddGetResponseToMux(
+		"/automation-studio/workflows",
+		`{"items": [{"name": "Other"}], "total": 1}`,
+		0,
+	)
+
+	res, err := runner.Lint(Request{
+		Args:    []string{"@testdata/workflows/lint.workflow.json"},
+		Options: &flags.WorkflowLintOptions{Format: "json"},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, res)
+
+	rules := lintRules(res.Object.([]workflowLintFinding))
+	assert.Contains(t, rules, "WF006:a1")
+	assert.Contains(t, rules, "WF006:b2")
+	assert.Contains(t, rules, "WF007:c3")
+
+	var doc []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(res.Text), &doc))
+	assert.Len(t, doc, len(rules))
+}
+
+func TestWorkflowLintConfig(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	cfg := filepath.Join(t.TempDir(), "lint.yaml")
+	require.NoError(t, os.WriteFile(cfg, []byte(
+		"rules:\n  WF001: off\n  no-path-to-end: warning\n  WF003: \"off\"\n  WF008: info\n",
+	), 0644))
+
+	res, err := runner.Lint(Request{
+		Args: []string{"@testdata/workflows/lint.workflow.json"},
+		Options: &flags.WorkflowLintOptions{
+			Format:     "sarif",
+			LintConfig: cfg,
+			Offline:    true,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(res.Text), &doc))
+	assert.Equal(t, "2.1.0", doc["version"])
+
+	results := doc["runs"].([]interface{})[0].(map[string]interface{})["results"].([]interface{})
+	assert.Len(t, results, 5)
+
+	last := results[len(results)-1].(map[string]interface{})
+	assert.Equal(t, "WF008", last["ruleId"])
+	assert.Equal(t, "note", last["level"])
+}
+
+func TestWorkflowLintConfigInvalid(t *testing.T) {
+	_, err := newWorkflowLinter(&workflowLintConfig{Rules: map[string]interface{}{"WF999": "error"}})
+	assert.Error(t, err)
+
+	_, err = newWorkflowLinter(&workflowLintConfig{Rules: map[string]interface{}{"WF001": "fatal"}})
+	assert.Error(t, err)
+}
+
+func TestWorkflowExportDiagram(t *testing.T) {
+	runner := NewWorkflowRunner(
+		testlib.Setup(),
+		testlib.DefaultConfig(),
+	)
+	defer testlib.Teardown()
+
+	path := t.TempDir()
+
+	for format, ext := range workflowDiagramFormats {
+		res, err := runner.Export(Request{
+			Args:    []string{"@testdata/workflows/lint.workflow.json"},
+			Options: &flags.WorkflowExportOptions{Format: format},
+			Common:  &flags.AssetExportCommon{Path: path},
+		})
+
+		require.NoError(t, err, format)
+		require.NotNil(t, res)
+
+		b, err := os.ReadFile(filepath.Join(path, "lint-test.workflow."+ext))
+		require.NoError(t, err, format)
+		assert.Equal(t, res.Object, string(b))
+	}
+
+	_, err := runner.Export(Request{
+		Args:    []string{"@testdata/workflows/lint.workflow.json"},
+		Options: &flags.WorkflowExportOptions{Format: "png"},
+		Common:  &flags.AssetExportCommon{Path: path},
+	})
+	assert.Error(t, err)
+}
+
+func TestRenderWorkflowDiagram(t *testing.T) {
+	wf := services.NewWorkflow("diagram")
+	wf.Tasks["a1"] = map[string]interface{}{"name": "doThing", "summary": `Say "hi"`, "type": "automatic"}
+	wf.Tasks["c1"] = map[string]interface{}{
+		"name":      "childJob",
+		"variables": map[string]interface{}{"incoming": map[string]interface{}{"workflow": "Child"}},
+	}
+	wf.Transitions["workflow_start"] = map[string]interface{}{
+		"a1": map[string]interface{}{"state": "success", "type": "standard"},
+	}
+	wf.Transitions["a1"] = map[string]interface{}{
+		"c1":           map[string]interface{}{"state": "error", "type": "standard"},
+		"workflow_end": map[string]interface{}{"state": "success", "type": "standard"},
+	}
+	wf.Transitions["c1"] = map[string]interface{}{
+		"a1": map[string]interface{}{"state": "success", "type": "revert"},
+	}
+
+	dot, err := renderWorkflowDiagram(wf, "dot")
+	require.NoError(t, err)
+	assert.Contains(t, dot, `"a1" [label="Say \"hi\""];`)
+	assert.Contains(t, dot, `"c1" [label="childJob\nchild workflow: Child", shape=box3d];`)
+	assert.Contains(t, dot, `"a1" -> "c1" [style=dashed, label="error"];`)
+	assert.Contains(t, dot, `"c1" -> "a1" [style=dotted, label="revert", constraint=false];`)
+	assert.Contains(t, dot, `"a1" -> "workflow_end" [style=solid];`)
+
+	mmd, err := renderWorkflowDiagram(wf, "mermaid")
+	require.NoError(t, err)
+	assert.Contains(t, mmd, `t_a1["Say #quot;hi#quot;"]`)
+	assert.Contains(t, mmd, `t_c1[["childJob<br/>child workflow: Child"]]`)
+	assert.Contains(t, mmd, "t_a1 -. error .-> t_c1")
+	assert.Contains(t, mmd, "t_c1 == revert ==> t_a1")
+	assert.Contains(t, mmd, "t_workflow_start --> t_a1")
+}
+
+// copyTestConfig returns the profile of the test server for every profile
+// name so the source and destination of a copy are the same test server.
+type copyTestConfig struct {
+	config.Provider
+}
+
+func (c copyTestConfig) GetProfile(name string) (*profile.Profile, error) {
+	p := *testlib.Profile()
+	p.Timeout = 5
+	return &p, nil
+}
+
+func TestWorkflowCopyDropsGroups(t *testing.T) {
+	testlib.Setup()
+	defer testlib.Teardown()
+
+	runner := NewWorkflowRunner(nil, copyTestConfig{testlib.DefaultConfig()})
+
+	testlib.AddPostResponseToMux("/workflow_builder/export", `{
+		"_id": "w1",
+		"name": "backup",
+		"tasks": {},
+		"transitions": {},
+		"gbac": {"read": ["source-group"], "write": ["source-group"]}
+	}`, http.StatusOK)
+
+	testlib.AddGetResponseToMux("/automation-studio/workflows", `{"items": [], "total": 0}`, 0)
+
+	var imported map[string]interface{}
+	testlib.AddHandlerToMux("POST /automation-studio/automations/import", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&imported))
+		w.Write([]byte(`{"imported": [{"success": true, "message": "ok"}]}`))
+	})
+
+	workflow, err := runner.CopyFrom("source", "backup")
+	require.NoError(t, err)
+
+	_, err = runner.CopyTo("destination", workflow, false)
+	require.NoError(t, err)
+
+	// the group ids of the source server are not valid on the destination
+	// server and must never be sent
+	require.NotNil(t, imported)
+	for _, ele := range imported["automations"].([]interface{}) {
+		assert.NotContains(t, ele, "gbac")
+	}
+}
@@ -23,6 +23,7 @@ var (
 	mux       *http.ServeMux
 	server    *httptest.Server
 	iapclient client.Client
+	prof      *profile.Profile
 )
 
 func Setup() client.Client {
@@ -52,7 +53,7 @@ func Setup() client.Client {
 		panic(err)
 	}
 
-	prof := &profile.Profile{
+	prof = &profile.Profile{
 		Host:   host,
 		Port:   port,
 		UseTLS: u.Scheme == "https",
@@ -63,6 +64,12 @@ func Setup() client.Client {
 	return iapclient
 }
 
+// Profile returns the profile used to connect to the test server.  It is
+// used by tests that create their own clients from a profile name.
+func Profile() *profile.Profile {
+	return prof
+}
+
 func Teardown() {
 	server.Close()
 }
@@ -0,0 +1,27 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package utils
+
+import (
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// UnifiedDiff compares the strings a and b line by line and returns the
+// differences in unified diff format.  The from and to arguments are used as
+// the file names in the diff header.  If a and b are identical, an empty
+// string is returned.
+func UnifiedDiff(a, b, from, to string) (string, error) {
+	if a == b {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+}
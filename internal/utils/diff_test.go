@@ -0,0 +1,31 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiffIdentical(t *testing.T) {
+	res, err := UnifiedDiff("hostname r1\n", "hostname r1\n", "a", "b")
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestUnifiedDiffChanged(t *testing.T) {
+	a := "hostname r1\ninterface lo0\n"
+	b := "hostname r2\ninterface lo0\n"
+
+	res, err := UnifiedDiff(a, b, "running", "rendered")
+
+	assert.NoError(t, err)
+	assert.Contains(t, res, "--- running")
+	assert.Contains(t, res, "+++ rendered")
+	assert.Contains(t, res, "-hostname r1")
+	assert.Contains(t, res, "+hostname r2")
+	assert.Contains(t, res, " interface lo0")
+}
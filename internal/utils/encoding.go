@@ -36,3 +36,38 @@ func UnmarshalData(data []byte, ptr any) error {
 	}
 	return nil
 }
+
+// NormalizeMap walks the map and converts any nested map[interface{}]interface{}
+// values, as produced when decoding YAML, into map[string]interface{} values.
+// This allows data loaded from YAML files to be safely encoded as JSON.
+func NormalizeMap(in map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for key, value := range in {
+		out[key] = NormalizeValue(value)
+	}
+	return out
+}
+
+// NormalizeValue converts any map[interface{}]interface{} values found in the
+// input, including values nested in maps and slices, into
+// map[string]interface{} values.
+func NormalizeValue(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprintf("%v", key)] = NormalizeValue(value)
+		}
+		return m
+	case map[string]interface{}:
+		return NormalizeMap(v)
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, value := range v {
+			l[i] = NormalizeValue(value)
+		}
+		return l
+	default:
+		return v
+	}
+}
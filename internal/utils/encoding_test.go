@@ -5,6 +5,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,3 +75,27 @@ func TestUnmarshalDataInvalid(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+// TestNormalizeMap verifies nested YAML maps are converted to string keyed
+// maps so they can be encoded as JSON.
+func TestNormalizeMap(t *testing.T) {
+	var data map[string]interface{}
+
+	err := UnmarshalData([]byte("device:\n  name: r1\n  vlans:\n    - id: 10\n"), &data)
+	assert.NoError(t, err)
+
+	res := NormalizeMap(data)
+
+	device, ok := res["device"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "r1", device["name"])
+
+	vlans, ok := device["vlans"].([]interface{})
+	assert.True(t, ok)
+	vlan, ok := vlans[0].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, 10, vlan["id"])
+
+	_, err = json.Marshal(res)
+	assert.NoError(t, err)
+}
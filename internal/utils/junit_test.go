@@ -0,0 +1,35 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJUnitTestSuitesMarshal(t *testing.T) {
+	report := JUnitTestSuites{Name: "compliance"}
+	report.AddSuite(JUnitTestSuite{
+		Name: "rtr1",
+		Cases: []JUnitTestCase{
+			{Name: "ntp"},
+			{Name: "snmp", Failure: &JUnitFailure{Message: "missing", Text: "snmp-server community x"}},
+		},
+	})
+
+	assert.Equal(t, 2, report.Tests)
+	assert.Equal(t, 1, report.Failures)
+	assert.Equal(t, 1, report.Suites[0].Failures)
+
+	b, err := report.Marshal()
+	assert.NoError(t, err)
+
+	out := string(b)
+	assert.True(t, strings.HasPrefix(out, "<?xml"))
+	assert.Contains(t, out, `<testsuites name="compliance" tests="2" failures="1">`)
+	assert.Contains(t, out, `<failure message="missing">snmp-server community x</failure>`)
+}
@@ -5,6 +5,7 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"os"
@@ -105,6 +106,13 @@ func WriteJsonToDisk(o any, fn, fp string) error {
 		return err
 	}
 
+	// Leave the file untouched when the content has not changed so
+	// re-exporting an unchanged asset does not rewrite the file
+	if current, err := os.ReadFile(dst); err == nil && bytes.Equal(current, b) {
+		logging.Debug("File `%s` is unchanged", dst)
+		return nil
+	}
+
 	return WriteBytesToDisk(b, dst, true)
 }
 
@@ -8,8 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type sampleStruct struct {
@@ -86,6 +88,31 @@ func TestWriteJsonToDisk(t *testing.T) {
 	assert.Contains(t, string(content), "25")
 }
 
+func TestWriteJsonToDiskUnchanged(t *testing.T) {
+	tmpDir := t.TempDir()
+	fp := filepath.Join(tmpDir, "data.json")
+
+	obj := map[string]interface{}{"b": 1, "a": []string{"x"}}
+
+	require.NoError(t, WriteJsonToDisk(obj, "data.json", tmpDir))
+	first, err := os.ReadFile(fp)
+	require.NoError(t, err)
+
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(fp, past, past))
+
+	require.NoError(t, WriteJsonToDisk(obj, "data.json", tmpDir))
+	second, err := os.ReadFile(fp)
+	require.NoError(t, err)
+
+	info, err := os.Stat(fp)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.True(t, info.ModTime().Equal(past))
+	assert.Equal(t, "{\n    \"a\": [\n        \"x\"\n    ],\n    \"b\": 1\n}", string(second))
+}
+
 func TestWriteYamlToDisk(t *testing.T) {
 	tmpDir := t.TempDir()
 	obj := sampleStruct{"Bob", 40}
@@ -0,0 +1,29 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+// Package jst evaluates JSON Schema Transformation (JST) documents locally
+// without connecting to an Itential Platform server.
+//
+// A JST document is stored as a services.Transformation.  The incoming and
+// outgoing fields are lists of JSON schemas identified by `$id` and the
+// steps field describes a graph of method and template nodes connected by
+// assign steps.  Run walks the graph starting from the assignments to the
+// outgoing values and evaluates each node the first time its result is
+// needed.
+//
+// # Functions
+//
+// The common functions of the String, Array, Object, Number, Math, JSON,
+// Boolean and Logic libraries are implemented natively in Go.  Functions
+// that require callbacks, such as Array.map, nested transformations and
+// any function not implemented by this package cause Run to return an
+// UnsupportedError before any step is evaluated.
+//
+// # Validation
+//
+// The incoming values are validated against the incoming schemas before
+// the transformation is run and the result is validated against the
+// outgoing schemas.  Validation failures are returned as a ValidationError.
+// Only the common JSON schema keywords are checked, see Validate.
+package jst
@@ -0,0 +1,621 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// function is the implementation of a built-in JST function.  Errors are
+// reported by calling the fail method on the arguments.
+type function func(a *arguments) any
+
+// arguments provides typed access to the arguments passed to a function.
+// The first conversion error is saved and returned once the function
+// completes.
+type arguments struct {
+	name   string
+	values []interface{}
+	err    error
+}
+
+func (a *arguments) fail(format string, args ...any) {
+	if a.err == nil {
+		a.err = fmt.Errorf("%s: %s", a.name, fmt.Sprintf(format, args...))
+	}
+}
+
+func (a *arguments) has(i int) bool {
+	return i < len(a.values) && a.values[i] != nil
+}
+
+func (a *arguments) get(i int) any {
+	if i < len(a.values) {
+		return a.values[i]
+	}
+	return nil
+}
+
+func (a *arguments) string(i int) string {
+	v, ok := a.get(i).(string)
+	if !ok {
+		a.fail("argument %v must be a string", i)
+	}
+	return v
+}
+
+func (a *arguments) number(i int) float64 {
+	v, ok := a.get(i).(float64)
+	if !ok {
+		a.fail("argument %v must be a number", i)
+	}
+	return v
+}
+
+func (a *arguments) int(i int) int {
+	return int(a.number(i))
+}
+
+// optionalInt returns the integer argument or def if it was not specified.
+func (a *arguments) optionalInt(i, def int) int {
+	if !a.has(i) {
+		return def
+	}
+	return a.int(i)
+}
+
+func (a *arguments) array(i int) []interface{} {
+	v, ok := a.get(i).([]interface{})
+	if !ok {
+		a.fail("argument %v must be an array", i)
+	}
+	return v
+}
+
+func (a *arguments) object(i int) map[string]interface{} {
+	v, ok := a.get(i).(map[string]interface{})
+	if !ok {
+		a.fail("argument %v must be an object", i)
+	}
+	return v
+}
+
+// rest returns the arguments starting at index i.
+func (a *arguments) rest(i int) []interface{} {
+	if i >= len(a.values) {
+		return nil
+	}
+	return a.values[i:]
+}
+
+// functions is the set of built-in functions that can be evaluated locally
+// grouped by library.
+var functions = map[string]map[string]function{
+	"String": {
+		"charAt": func(a *arguments) any {
+			r := []rune(a.string(0))
+			i := a.optionalInt(1, 0)
+			if i < 0 || i >= len(r) {
+				return ""
+			}
+			return string(r[i])
+		},
+		"concat": func(a *arguments) any {
+			var b strings.Builder
+			b.WriteString(a.string(0))
+			for _, ele := range a.rest(1) {
+				b.WriteString(toString(ele))
+			}
+			return b.String()
+		},
+		"endsWith":    func(a *arguments) any { return strings.HasSuffix(a.string(0), a.string(1)) },
+		"includes":    func(a *arguments) any { return strings.Contains(a.string(0), a.string(1)) },
+		"indexOf":     func(a *arguments) any { return runeIndex(a.string(0), a.string(1), false) },
+		"lastIndexOf": func(a *arguments) any { return runeIndex(a.string(0), a.string(1), true) },
+		"padEnd":      func(a *arguments) any { return pad(a, false) },
+		"padStart":    func(a *arguments) any { return pad(a, true) },
+		"repeat": func(a *arguments) any {
+			n := a.int(1)
+			if n < 0 {
+				a.fail("count must not be negative")
+				return nil
+			}
+			return strings.Repeat(a.string(0), n)
+		},
+		"replace":    func(a *arguments) any { return strings.Replace(a.string(0), a.string(1), a.string(2), 1) },
+		"replaceAll": func(a *arguments) any { return strings.ReplaceAll(a.string(0), a.string(1), a.string(2)) },
+		"slice": func(a *arguments) any {
+			r := []rune(a.string(0))
+			start, end := sliceRange(len(r), a.optionalInt(1, 0), a.optionalInt(2, len(r)))
+			return string(r[start:end])
+		},
+		"split": func(a *arguments) any {
+			s := a.string(0)
+			var parts []string
+			if !a.has(1) {
+				parts = []string{s}
+			} else {
+				parts = strings.Split(s, a.string(1))
+			}
+			res := make([]interface{}, len(parts))
+			for idx, ele := range parts {
+				res[idx] = ele
+			}
+			return res
+		},
+		"startsWith": func(a *arguments) any { return strings.HasPrefix(a.string(0), a.string(1)) },
+		"substring": func(a *arguments) any {
+			r := []rune(a.string(0))
+			clamp := func(i int) int { return max(0, min(i, len(r))) }
+			start, end := clamp(a.optionalInt(1, 0)), clamp(a.optionalInt(2, len(r)))
+			if start > end {
+				start, end = end, start
+			}
+			return string(r[start:end])
+		},
+		"toLowerCase": func(a *arguments) any { return strings.ToLower(a.string(0)) },
+		"toString":    func(a *arguments) any { return toString(a.get(0)) },
+		"toUpperCase": func(a *arguments) any { return strings.ToUpper(a.string(0)) },
+		"trim":        func(a *arguments) any { return strings.TrimSpace(a.string(0)) },
+		"trimEnd":     func(a *arguments) any { return strings.TrimRight(a.string(0), " \t\n\r\v\f") },
+		"trimStart":   func(a *arguments) any { return strings.TrimLeft(a.string(0), " \t\n\r\v\f") },
+	},
+
+	"Array": {
+		"concat": func(a *arguments) any {
+			res := append([]interface{}{}, a.array(0)...)
+			for _, ele := range a.rest(1) {
+				if arr, ok := ele.([]interface{}); ok {
+					res = append(res, arr...)
+				} else {
+					res = append(res, ele)
+				}
+			}
+			return res
+		},
+		"flat": func(a *arguments) any { return flatten(a.array(0), a.optionalInt(1, 1)) },
+		"includes": func(a *arguments) any {
+			return indexOf(a.array(0), a.get(1)) >= 0
+		},
+		"indexOf": func(a *arguments) any { return float64(indexOf(a.array(0), a.get(1))) },
+		"isArray": func(a *arguments) any {
+			_, ok := a.get(0).([]interface{})
+			return ok
+		},
+		"join": func(a *arguments) any {
+			sep := ","
+			if a.has(1) {
+				sep = a.string(1)
+			}
+			arr := a.array(0)
+			parts := make([]string, len(arr))
+			for idx, ele := range arr {
+				if ele != nil {
+					parts[idx] = toString(ele)
+				}
+			}
+			return strings.Join(parts, sep)
+		},
+		"push": func(a *arguments) any {
+			return append(append([]interface{}{}, a.array(0)...), a.rest(1)...)
+		},
+		"reverse": func(a *arguments) any {
+			arr := a.array(0)
+			res := make([]interface{}, len(arr))
+			for idx, ele := range arr {
+				res[len(arr)-1-idx] = ele
+			}
+			return res
+		},
+		"slice": func(a *arguments) any {
+			arr := a.array(0)
+			start, end := sliceRange(len(arr), a.optionalInt(1, 0), a.optionalInt(2, len(arr)))
+			return append([]interface{}{}, arr[start:end]...)
+		},
+		"sort": func(a *arguments) any {
+			// the default sort order compares the string values of the
+			// elements the same way as JavaScript
+			res := append([]interface{}{}, a.array(0)...)
+			sort.SliceStable(res, func(i, j int) bool { return toString(res[i]) < toString(res[j]) })
+			return res
+		},
+		"unshift": func(a *arguments) any {
+			return append(append([]interface{}{}, a.rest(1)...), a.array(0)...)
+		},
+	},
+
+	"Object": {
+		"assign": func(a *arguments) any {
+			res := map[string]interface{}{}
+			for idx := range a.values {
+				for key, value := range a.object(idx) {
+					res[key] = value
+				}
+			}
+			return res
+		},
+		"entries": func(a *arguments) any {
+			obj := a.object(0)
+			res := []interface{}{}
+			for _, key := range sortedKeys(obj) {
+				res = append(res, []interface{}{key, obj[key]})
+			}
+			return res
+		},
+		"fromEntries": func(a *arguments) any {
+			res := map[string]interface{}{}
+			for _, ele := range a.array(0) {
+				pair, ok := ele.([]interface{})
+				if !ok || len(pair) != 2 {
+					a.fail("each entry must be a [key, value] array")
+					return nil
+				}
+				res[toString(pair[0])] = pair[1]
+			}
+			return res
+		},
+		"hasOwnProperty": func(a *arguments) any {
+			_, exists := a.object(0)[a.string(1)]
+			return exists
+		},
+		"keys": func(a *arguments) any {
+			res := []interface{}{}
+			for _, key := range sortedKeys(a.object(0)) {
+				res = append(res, key)
+			}
+			return res
+		},
+		"values": func(a *arguments) any {
+			obj := a.object(0)
+			res := []interface{}{}
+			for _, key := range sortedKeys(obj) {
+				res = append(res, obj[key])
+			}
+			return res
+		},
+	},
+
+	"Number": {
+		"isFinite": func(a *arguments) any {
+			_, ok := a.get(0).(float64)
+			return ok
+		},
+		"isInteger": func(a *arguments) any {
+			v, ok := a.get(0).(float64)
+			return ok && v == math.Trunc(v)
+		},
+		"parseFloat": func(a *arguments) any {
+			s := strings.TrimSpace(toString(a.get(0)))
+			if m := floatPrefixExpr.FindString(s); m != "" {
+				v, _ := strconv.ParseFloat(m, 64)
+				return v
+			}
+			a.fail("unable to parse `%s` as a number", s)
+			return nil
+		},
+		"parseInt": func(a *arguments) any {
+			s := strings.TrimSpace(toString(a.get(0)))
+			radix := a.optionalInt(1, 10)
+			if radix == 16 {
+				s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+			}
+			end := 0
+			for end < len(s) {
+				if (end == 0 && (s[0] == '-' || s[0] == '+')) || digitValue(s[end]) < radix {
+					end++
+					continue
+				}
+				break
+			}
+			v, err := strconv.ParseInt(s[:end], radix, 64)
+			if err != nil {
+				a.fail("unable to parse `%s` as an integer", s)
+				return nil
+			}
+			return float64(v)
+		},
+		"toFixed": func(a *arguments) any {
+			return strconv.FormatFloat(a.number(0), 'f', a.optionalInt(1, 0), 64)
+		},
+		"toString": func(a *arguments) any {
+			if radix := a.optionalInt(1, 10); radix != 10 {
+				return strconv.FormatInt(int64(a.number(0)), radix)
+			}
+			return toString(a.number(0))
+		},
+	},
+
+	"Math": {
+		"abs":   func(a *arguments) any { return math.Abs(a.number(0)) },
+		"ceil":  func(a *arguments) any { return math.Ceil(a.number(0)) },
+		"floor": func(a *arguments) any { return math.Floor(a.number(0)) },
+		"max": func(a *arguments) any {
+			res := math.Inf(-1)
+			for idx := range a.values {
+				res = math.Max(res, a.number(idx))
+			}
+			return res
+		},
+		"min": func(a *arguments) any {
+			res := math.Inf(1)
+			for idx := range a.values {
+				res = math.Min(res, a.number(idx))
+			}
+			return res
+		},
+		"pow":   func(a *arguments) any { return math.Pow(a.number(0), a.number(1)) },
+		"round": func(a *arguments) any { return math.Floor(a.number(0) + 0.5) },
+		"sign": func(a *arguments) any {
+			v := a.number(0)
+			switch {
+			case v > 0:
+				return 1.0
+			case v < 0:
+				return -1.0
+			}
+			return 0.0
+		},
+		"sqrt":  func(a *arguments) any { return math.Sqrt(a.number(0)) },
+		"trunc": func(a *arguments) any { return math.Trunc(a.number(0)) },
+	},
+
+	"JSON": {
+		"parse": func(a *arguments) any {
+			var res any
+			if err := json.Unmarshal([]byte(a.string(0)), &res); err != nil {
+				a.fail("%s", err)
+			}
+			return res
+		},
+		"stringify": func(a *arguments) any {
+			var b []byte
+			var err error
+			if indent := a.optionalInt(2, 0); indent > 0 {
+				b, err = json.MarshalIndent(a.get(0), "", strings.Repeat(" ", indent))
+			} else {
+				b, err = json.Marshal(a.get(0))
+			}
+			if err != nil {
+				a.fail("%s", err)
+			}
+			return string(b)
+		},
+	},
+
+	"Boolean": {
+		"toString": func(a *arguments) any { return toString(truthy(a.get(0))) },
+		"valueOf":  func(a *arguments) any { return truthy(a.get(0)) },
+	},
+
+	"Logic": {
+		"and": func(a *arguments) any {
+			for _, ele := range a.values {
+				if !truthy(ele) {
+					return false
+				}
+			}
+			return true
+		},
+		"equal":              func(a *arguments) any { return reflect.DeepEqual(a.get(0), a.get(1)) },
+		"greaterThan":        func(a *arguments) any { return compare(a) > 0 },
+		"greaterThanOrEqual": func(a *arguments) any { return compare(a) >= 0 },
+		"lessThan":           func(a *arguments) any { return compare(a) < 0 },
+		"lessThanOrEqual":    func(a *arguments) any { return compare(a) <= 0 },
+		"not":                func(a *arguments) any { return !truthy(a.get(0)) },
+		"notEqual":           func(a *arguments) any { return !reflect.DeepEqual(a.get(0), a.get(1)) },
+		"or": func(a *arguments) any {
+			for _, ele := range a.values {
+				if truthy(ele) {
+					return true
+				}
+			}
+			return false
+		},
+	},
+}
+
+var floatPrefixExpr = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?`)
+
+// Functions returns the names of the functions that can be evaluated
+// locally in the form `Library.method`.
+func Functions() []string {
+	var res []string
+	for library, methods := range functions {
+		for method := range methods {
+			res = append(res, library+"."+method)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func lookupFunction(library, method string) function {
+	return functions[library][method]
+}
+
+// call runs the built-in function with the arguments.
+func call(library, method string, values []interface{}) (any, error) {
+	fn := lookupFunction(library, method)
+	if fn == nil {
+		return nil, UnsupportedError{Kind: "function", Name: library + "." + method}
+	}
+
+	a := &arguments{name: library + "." + method, values: values}
+
+	res := fn(a)
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	return normalize(res), nil
+}
+
+// normalize converts the integer results returned by some functions to
+// float64 so all numbers have the same type as decoded JSON numbers.
+func normalize(in any) any {
+	if v, ok := in.(int); ok {
+		return float64(v)
+	}
+	return in
+}
+
+// toString converts a value to a string the same way as JavaScript.
+func toString(in any) string {
+	switch v := in.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for idx, ele := range v {
+			if ele != nil {
+				parts[idx] = toString(ele)
+			}
+		}
+		return strings.Join(parts, ",")
+	case map[string]interface{}:
+		return "[object Object]"
+	}
+	return fmt.Sprint(in)
+}
+
+// truthy returns the boolean value of the value the same way as JavaScript.
+func truthy(in any) bool {
+	switch v := in.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0 && !math.IsNaN(v)
+	case string:
+		return v != ""
+	}
+	return true
+}
+
+// compare compares the first two arguments which must both be numbers or
+// both be strings.
+func compare(a *arguments) int {
+	if s, ok := a.get(0).(string); ok {
+		return strings.Compare(s, a.string(1))
+	}
+	x, y := a.number(0), a.number(1)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func indexOf(arr []interface{}, value any) int {
+	for idx, ele := range arr {
+		if reflect.DeepEqual(ele, value) {
+			return idx
+		}
+	}
+	return -1
+}
+
+func flatten(arr []interface{}, depth int) []interface{} {
+	res := []interface{}{}
+	for _, ele := range arr {
+		if inner, ok := ele.([]interface{}); ok && depth > 0 {
+			res = append(res, flatten(inner, depth-1)...)
+		} else {
+			res = append(res, ele)
+		}
+	}
+	return res
+}
+
+func sortedKeys(in map[string]interface{}) []string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sliceRange converts the start and end arguments of a slice call, which
+// may be negative, to indexes between 0 and length.
+func sliceRange(length, start, end int) (int, int) {
+	resolve := func(i int) int {
+		if i < 0 {
+			i += length
+		}
+		return max(0, min(i, length))
+	}
+	start, end = resolve(start), resolve(end)
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+// runeIndex returns the character index of substr in s or -1.
+func runeIndex(s, substr string, last bool) float64 {
+	var idx int
+	if last {
+		idx = strings.LastIndex(s, substr)
+	} else {
+		idx = strings.Index(s, substr)
+	}
+	if idx < 0 {
+		return -1
+	}
+	return float64(utf8.RuneCountInString(s[:idx]))
+}
+
+func pad(a *arguments, start bool) string {
+	s := a.string(0)
+	length := a.int(1)
+	fill := " "
+	if a.has(2) {
+		fill = a.string(2)
+	}
+
+	missing := length - utf8.RuneCountInString(s)
+	if missing <= 0 || fill == "" {
+		return s
+	}
+
+	padding := []rune(strings.Repeat(fill, missing/utf8.RuneCountInString(fill)+1))[:missing]
+
+	if start {
+		return string(padding) + s
+	}
+	return s + string(padding)
+}
+
+func digitValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return 36
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	arr := func(values ...interface{}) []interface{} { return values }
+
+	tests := []struct {
+		fn       string
+		args     []interface{}
+		expected any
+	}{
+		{"String.charAt", arr("héllo", 1.0), "é"},
+		{"String.concat", arr("a", "b", 1.0), "ab1"},
+		{"String.indexOf", arr("héllo", "l"), 2.0},
+		{"String.lastIndexOf", arr("hello", "l"), 3.0},
+		{"String.padStart", arr("7", 3.0, "0"), "007"},
+		{"String.padEnd", arr("ab", 5.0, "xy"), "abxyx"},
+		{"String.replace", arr("a-b-c", "-", "+"), "a+b-c"},
+		{"String.replaceAll", arr("a-b-c", "-", "+"), "a+b+c"},
+		{"String.slice", arr("hello", -3.0), "llo"},
+		{"String.split", arr("a,b", ","), arr("a", "b")},
+		{"String.substring", arr("hello", 3.0, 1.0), "el"},
+		{"String.toUpperCase", arr("abc"), "ABC"},
+		{"String.trim", arr("  abc \n"), "abc"},
+
+		{"Array.concat", arr(arr(1.0), arr(2.0, 3.0), 4.0), arr(1.0, 2.0, 3.0, 4.0)},
+		{"Array.flat", arr(arr(1.0, arr(2.0, arr(3.0)))), arr(1.0, 2.0, arr(3.0))},
+		{"Array.includes", arr(arr("a", "b"), "b"), true},
+		{"Array.indexOf", arr(arr("a", "b"), "c"), -1.0},
+		{"Array.join", arr(arr("a", 1.0, nil)), "a,1,"},
+		{"Array.push", arr(arr(1.0), 2.0), arr(1.0, 2.0)},
+		{"Array.reverse", arr(arr(1.0, 2.0)), arr(2.0, 1.0)},
+		{"Array.slice", arr(arr(1.0, 2.0, 3.0), 1.0), arr(2.0, 3.0)},
+		{"Array.sort", arr(arr(10.0, 9.0, 1.0)), arr(1.0, 10.0, 9.0)},
+		{"Array.unshift", arr(arr(2.0), 1.0), arr(1.0, 2.0)},
+
+		{"Object.assign", arr(map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": 2.0, "b": 3.0}), map[string]interface{}{"a": 2.0, "b": 3.0}},
+		{"Object.entries", arr(map[string]interface{}{"b": 2.0, "a": 1.0}), arr(arr("a", 1.0), arr("b", 2.0))},
+		{"Object.fromEntries", arr(arr(arr("a", 1.0))), map[string]interface{}{"a": 1.0}},
+		{"Object.hasOwnProperty", arr(map[string]interface{}{"a": nil}, "a"), true},
+		{"Object.keys", arr(map[string]interface{}{}), []interface{}{}},
+		{"Object.values", arr(map[string]interface{}{"b": 2.0, "a": 1.0}), arr(1.0, 2.0)},
+
+		{"Number.isInteger", arr(2.5), false},
+		{"Number.parseFloat", arr("3.14abc"), 3.14},
+		{"Number.parseInt", arr("42px"), 42.0},
+		{"Number.parseInt", arr("ff", 16.0), 255.0},
+		{"Number.toFixed", arr(3.14159, 2.0), "3.14"},
+		{"Number.toString", arr(255.0, 16.0), "ff"},
+
+		{"Math.max", arr(1.0, 5.0, 3.0), 5.0},
+		{"Math.min", arr(1.0, 5.0, 3.0), 1.0},
+		{"Math.round", arr(2.5), 3.0},
+		{"Math.sign", arr(-2.0), -1.0},
+
+		{"JSON.parse", arr(`{"a": [1]}`), map[string]interface{}{"a": arr(1.0)}},
+		{"JSON.stringify", arr(map[string]interface{}{"b": 1.0, "a": true}), `{"a":true,"b":1}`},
+
+		{"Boolean.valueOf", arr(""), false},
+		{"Boolean.toString", arr(1.0), "true"},
+
+		{"Logic.and", arr(true, 1.0, "x"), true},
+		{"Logic.or", arr(false, 0.0, ""), false},
+		{"Logic.not", arr(nil), true},
+		{"Logic.equal", arr(arr(1.0), arr(1.0)), true},
+		{"Logic.notEqual", arr("a", "b"), true},
+		{"Logic.greaterThan", arr(2.0, 1.0), true},
+		{"Logic.lessThanOrEqual", arr("a", "b"), true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.fn, func(t *testing.T) {
+			library, method, _ := strings.Cut(tc.fn, ".")
+			res, err := call(library, method, tc.args)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCallErrors(t *testing.T) {
+	_, err := call("String", "toUpperCase", []interface{}{1.0})
+	assert.EqualError(t, err, "String.toUpperCase: argument 0 must be a string")
+
+	_, err = call("JSON", "parse", []interface{}{"{"})
+	assert.ErrorContains(t, err, "JSON.parse:")
+
+	_, err = call("Number", "parseInt", []interface{}{"abc"})
+	assert.EqualError(t, err, "Number.parseInt: unable to parse `abc` as an integer")
+
+	_, err = call("Array", "map", nil)
+	assert.ErrorAs(t, err, &UnsupportedError{})
+}
+
+func TestFunctions(t *testing.T) {
+	res := Functions()
+	assert.Contains(t, res, "String.split")
+	assert.Contains(t, res, "Logic.and")
+	assert.NotContains(t, res, "Array.map")
+	assert.IsIncreasing(t, res)
+}
@@ -0,0 +1,363 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/services"
+)
+
+const (
+	stepAssign   = "assign"
+	stepMethod   = "method"
+	stepTemplate = "template"
+
+	locationIncoming = "incoming"
+	locationOutgoing = "outgoing"
+	locationMethod   = "method"
+	locationTemplate = "template"
+
+	rootContext = "#"
+)
+
+var templatePlaceholderExpr = regexp.MustCompile(`\$\{\s*([^}]*?)\s*\}`)
+
+// UnsupportedError is returned when a transformation uses a feature that
+// cannot be evaluated locally.
+type UnsupportedError struct {
+	Kind string
+	Name string
+	Step any
+}
+
+func (e UnsupportedError) Error() string {
+	return fmt.Sprintf("unsupported %s `%s` in step %v, the transformation must be run on the server", e.Kind, e.Name, e.Step)
+}
+
+// ValidationError is returned when the incoming or outgoing values do not
+// match the schemas defined by the transformation.
+type ValidationError struct {
+	Location string
+	Problems []string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s values do not match the schema:\n  - %s", e.Location, strings.Join(e.Problems, "\n  - "))
+}
+
+// evaluator holds the state for a single run of a transformation.
+type evaluator struct {
+	incoming map[string]interface{}
+	outgoing map[string]interface{}
+	nodes    map[string]map[string]interface{}
+	assigns  []map[string]interface{}
+	results  map[string]interface{}
+	visiting map[string]bool
+}
+
+// Run evaluates the transformation using the incoming values and returns
+// the outgoing values.  The incoming values are keyed by the `$id` of the
+// incoming schemas.
+func Run(t services.Transformation, incoming map[string]interface{}) (map[string]interface{}, error) {
+	logging.Trace()
+
+	// round trip the values through JSON so numbers are always float64 and
+	// objects are always map[string]interface{} regardless of how the
+	// values were loaded
+	b, err := json.Marshal(incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	incoming = map[string]interface{}{}
+	if err := json.Unmarshal(b, &incoming); err != nil {
+		return nil, err
+	}
+
+	if problems := validateValues(t.Incoming, incoming, "is required"); len(problems) > 0 {
+		return nil, ValidationError{Location: locationIncoming, Problems: problems}
+	}
+
+	e, err := newEvaluator(t, incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ele := range e.assigns {
+		to := stepRef(ele, "to")
+		if to["location"] != locationOutgoing {
+			continue
+		}
+
+		value, err := e.value(stepRef(ele, "from"))
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprint(to["name"])
+		ptr, _ := to["ptr"].(string)
+
+		res, err := setPointer(e.outgoing[name], ptr, value)
+		if err != nil {
+			return nil, fmt.Errorf("step %v: %s", ele["id"], err)
+		}
+		e.outgoing[name] = res
+	}
+
+	if problems := validateValues(t.Outgoing, e.outgoing, "was not assigned"); len(problems) > 0 {
+		return e.outgoing, ValidationError{Location: locationOutgoing, Problems: problems}
+	}
+
+	return e.outgoing, nil
+}
+
+// newEvaluator indexes the steps of the transformation and checks that
+// every step and function is supported before anything is evaluated.
+func newEvaluator(t services.Transformation, incoming map[string]interface{}) (*evaluator, error) {
+	e := &evaluator{
+		incoming: incoming,
+		outgoing: map[string]interface{}{},
+		nodes:    map[string]map[string]interface{}{},
+		results:  map[string]interface{}{},
+		visiting: map[string]bool{},
+	}
+
+	for _, ele := range t.Steps {
+		if ctx, _ := ele["context"].(string); ctx != "" && ctx != rootContext {
+			return nil, UnsupportedError{Kind: "context", Name: ctx, Step: ele["id"]}
+		}
+
+		kind, _ := ele["type"].(string)
+
+		switch kind {
+		case stepAssign:
+			for _, key := range []string{"from", "to"} {
+				location := fmt.Sprint(stepRef(ele, key)["location"])
+				switch location {
+				case locationIncoming, locationOutgoing, locationMethod, locationTemplate:
+				default:
+					return nil, UnsupportedError{Kind: "location", Name: location, Step: ele["id"]}
+				}
+			}
+			e.assigns = append(e.assigns, ele)
+		case stepMethod, stepTemplate:
+			library, _ := ele["library"].(string)
+			method, _ := ele["method"].(string)
+			if kind == stepMethod && lookupFunction(library, method) == nil {
+				return nil, UnsupportedError{Kind: "function", Name: library + "." + method, Step: ele["id"]}
+			}
+			e.nodes[fmt.Sprint(ele["id"])] = ele
+		default:
+			return nil, UnsupportedError{Kind: "step type", Name: kind, Step: ele["id"]}
+		}
+	}
+
+	sort.SliceStable(e.assigns, func(i, j int) bool {
+		return stepIdLess(e.assigns[i]["id"], e.assigns[j]["id"])
+	})
+
+	return e, nil
+}
+
+// stepIdLess reports whether step id a sorts before step id b.  Numeric ids
+// are compared as numbers so step 2 runs before step 10 and sort before all
+// other ids, which are compared as strings.
+func stepIdLess(a, b any) bool {
+	x, errX := strconv.ParseFloat(fmt.Sprint(a), 64)
+	y, errY := strconv.ParseFloat(fmt.Sprint(b), 64)
+	switch {
+	case errX == nil && errY == nil:
+		return x < y
+	case errX == nil || errY == nil:
+		return errX == nil
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// value returns the value referenced by the `from` side of an assign step.
+func (e *evaluator) value(ref map[string]interface{}) (any, error) {
+	name := fmt.Sprint(ref["name"])
+	ptr, _ := ref["ptr"].(string)
+
+	switch ref["location"] {
+	case locationIncoming:
+		return getPointer(e.incoming[name], ptr)
+	case locationOutgoing:
+		return getPointer(e.outgoing[name], ptr)
+	}
+
+	res, err := e.evaluate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPointer(res, ptr)
+}
+
+// evaluate runs the method or template node with the specified id and
+// returns an object with the result in the `return` field.  Results are
+// cached so each node is only evaluated once.
+func (e *evaluator) evaluate(id string) (any, error) {
+	if res, exists := e.results[id]; exists {
+		return res, nil
+	}
+
+	if e.visiting[id] {
+		return nil, fmt.Errorf("step %s is part of a cycle", id)
+	}
+	e.visiting[id] = true
+	defer delete(e.visiting, id)
+
+	node, exists := e.nodes[id]
+	if !exists {
+		return nil, fmt.Errorf("step %s does not exist", id)
+	}
+
+	var doc any = map[string]interface{}{"args": clone(node["args"])}
+
+	for _, ele := range e.assigns {
+		to := stepRef(ele, "to")
+		if to["location"] != node["type"] || fmt.Sprint(to["name"]) != id {
+			continue
+		}
+
+		value, err := e.value(stepRef(ele, "from"))
+		if err != nil {
+			return nil, err
+		}
+
+		ptr, _ := to["ptr"].(string)
+
+		doc, err = setPointer(doc, ptr, value)
+		if err != nil {
+			return nil, fmt.Errorf("step %v: %s", ele["id"], err)
+		}
+	}
+
+	// an assign with an empty pointer replaces the whole document
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("step %s: arguments must be an object, got %T", id, doc)
+	}
+
+	args, _ := m["args"].([]interface{})
+
+	values := make([]interface{}, len(args))
+	for idx, ele := range args {
+		values[idx] = argValue(ele)
+	}
+
+	var res any
+	var err error
+
+	library, _ := node["library"].(string)
+	method, _ := node["method"].(string)
+
+	if node["type"] == stepTemplate {
+		template, _ := node["template"].(string)
+		res = templateLiteral(template, values)
+	} else {
+		res, err = call(library, method, values)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("step %s: %s", id, err)
+	}
+
+	result := map[string]interface{}{"return": res}
+	e.results[id] = result
+
+	return result, nil
+}
+
+// templateLiteral replaces the placeholders in the template with the
+// arguments.  Arguments are matched to the unique placeholder names in the
+// order they first appear in the template.
+func templateLiteral(template string, args []interface{}) string {
+	names := map[string]int{}
+
+	for _, ele := range templatePlaceholderExpr.FindAllStringSubmatch(template, -1) {
+		if _, exists := names[ele[1]]; !exists {
+			names[ele[1]] = len(names)
+		}
+	}
+
+	return templatePlaceholderExpr.ReplaceAllStringFunc(template, func(match string) string {
+		name := templatePlaceholderExpr.FindStringSubmatch(match)[1]
+		if idx := names[name]; idx < len(args) {
+			return toString(args[idx])
+		}
+		return "undefined"
+	})
+}
+
+// validateValues checks each value against the schema with the same `$id`.
+func validateValues(schemas []map[string]interface{}, values map[string]interface{}, missing string) []string {
+	var problems []string
+
+	for _, schema := range schemas {
+		id, _ := schema["$id"].(string)
+		if id == "" {
+			continue
+		}
+
+		value, exists := values[id]
+		if !exists {
+			problems = append(problems, fmt.Sprintf("%s %s", id, missing))
+			continue
+		}
+
+		problems = append(problems, Validate(schema, value, id)...)
+	}
+
+	return problems
+}
+
+// stepRef returns the `from` or `to` object of an assign step.
+func stepRef(step map[string]interface{}, key string) map[string]interface{} {
+	res, _ := step[key].(map[string]interface{})
+	if res == nil {
+		return map[string]interface{}{}
+	}
+	return res
+}
+
+// argValue returns the value of a method argument.  Arguments that have been
+// assigned are stored as an object with a `value` field, all other arguments
+// are static values.
+func argValue(in any) any {
+	if m, ok := in.(map[string]interface{}); ok {
+		if v, exists := m["value"]; exists {
+			return v
+		}
+	}
+	return in
+}
+
+// clone returns a deep copy of a decoded JSON value.
+func clone(in any) any {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			res[key] = clone(value)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for idx, value := range v {
+			res[idx] = clone(value)
+		}
+		return res
+	}
+	return in
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/itential/ipctl/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTransformation(t *testing.T, path string) services.Transformation {
+	var res services.Transformation
+	require.NoError(t, json.Unmarshal([]byte(testlib.Fixture(path)), &res))
+	return res
+}
+
+func methodStep(id int, library, method string, args ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id": float64(id), "type": "method", "context": "#",
+		"library": library, "method": method, "args": args,
+	}
+}
+
+func assignStep(id int, fromLocation string, fromName any, fromPtr, toLocation string, toName any, toPtr string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": float64(id), "type": "assign", "context": "#",
+		"from": map[string]interface{}{"location": fromLocation, "name": fromName, "ptr": fromPtr},
+		"to":   map[string]interface{}{"location": toLocation, "name": toName, "ptr": toPtr},
+	}
+}
+
+func TestRun(t *testing.T) {
+	jst := loadTransformation(t, "testdata/ip_assignment.json")
+
+	res, err := Run(jst, map[string]interface{}{
+		"status":      "active",
+		"description": "uplink",
+		"comments":    "set by test",
+		"interfaceID": float64(42),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"payload": []interface{}{
+			map[string]interface{}{
+				"status":      "active",
+				"description": "uplink",
+				"comments":    "set by test",
+				"interface":   float64(42),
+			},
+		},
+	}, res)
+}
+
+func TestRunIncomingValidation(t *testing.T) {
+	jst := loadTransformation(t, "testdata/ip_assignment.json")
+
+	_, err := Run(jst, map[string]interface{}{
+		"status":      "active",
+		"description": "uplink",
+		"interfaceID": "42",
+	})
+
+	var verr ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "incoming", verr.Location)
+	assert.Equal(t, []string{
+		"comments is required",
+		"interfaceID must be of type number, got string",
+	}, verr.Problems)
+}
+
+func TestRunOutgoingValidation(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Incoming = []map[string]interface{}{{"$id": "name", "type": "string"}}
+	jst.Outgoing = []map[string]interface{}{
+		{"$id": "upper", "type": "string", "maxLength": float64(3)},
+		{"$id": "unused", "type": "string"},
+	}
+	jst.Steps = []map[string]interface{}{
+		methodStep(1, "String", "toUpperCase", nil),
+		assignStep(2, "incoming", "name", "", "method", 1, "/args/0/value"),
+		assignStep(3, "method", 1, "/return", "outgoing", "upper", ""),
+	}
+
+	res, err := Run(jst, map[string]interface{}{"name": "test"})
+
+	var verr ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "outgoing", verr.Location)
+	assert.Equal(t, []string{
+		"upper must be at most 3 characters",
+		"unused was not assigned",
+	}, verr.Problems)
+	assert.Equal(t, "TEST", res["upper"])
+}
+
+func TestRunChainedMethods(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Incoming = []map[string]interface{}{{"$id": "hosts", "type": "string"}}
+	jst.Outgoing = []map[string]interface{}{{"$id": "result", "type": "object"}}
+	jst.Steps = []map[string]interface{}{
+		methodStep(1, "String", "split", nil, ","),
+		methodStep(2, "Array", "sort", nil),
+		methodStep(3, "Array", "join", nil, map[string]interface{}{"value": ";"}),
+		assignStep(10, "incoming", "hosts", "", "method", 1, "/args/0/value"),
+		assignStep(11, "method", 1, "/return", "method", 2, "/args/0/value"),
+		assignStep(12, "method", 2, "/return", "method", 3, "/args/0/value"),
+		assignStep(13, "method", 3, "/return", "outgoing", "result", "/joined"),
+		assignStep(14, "method", 1, "/return/0", "outgoing", "result", "/first"),
+	}
+
+	res, err := Run(jst, map[string]interface{}{"hosts": "c,a,b"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"result": map[string]interface{}{"joined": "a;b;c", "first": "c"},
+	}, res)
+}
+
+func TestRunUnsupported(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Steps = []map[string]interface{}{methodStep(1, "Array", "map", nil, nil)}
+
+	_, err := Run(jst, nil)
+
+	var uerr UnsupportedError
+	require.ErrorAs(t, err, &uerr)
+	assert.Equal(t, "function", uerr.Kind)
+	assert.Equal(t, "Array.map", uerr.Name)
+	assert.Contains(t, err.Error(), "unsupported function `Array.map` in step 1")
+
+	jst.Steps = []map[string]interface{}{{"id": float64(1), "type": "transformation", "context": "#"}}
+	_, err = Run(jst, nil)
+	require.ErrorAs(t, err, &uerr)
+	assert.Equal(t, "step type", uerr.Kind)
+
+	jst.Steps = []map[string]interface{}{{"id": float64(1), "type": "method", "context": "#/2/callback"}}
+	_, err = Run(jst, nil)
+	require.ErrorAs(t, err, &uerr)
+	assert.Equal(t, "context", uerr.Kind)
+}
+
+func TestRunCycle(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Outgoing = []map[string]interface{}{{"$id": "out"}}
+	jst.Steps = []map[string]interface{}{
+		methodStep(1, "String", "toUpperCase", nil),
+		methodStep(2, "String", "toLowerCase", nil),
+		assignStep(10, "method", 2, "/return", "method", 1, "/args/0/value"),
+		assignStep(11, "method", 1, "/return", "method", 2, "/args/0/value"),
+		assignStep(12, "method", 1, "/return", "outgoing", "out", ""),
+	}
+
+	_, err := Run(jst, nil)
+	assert.ErrorContains(t, err, "is part of a cycle")
+}
+
+func TestRunAssignOrder(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Incoming = []map[string]interface{}{{"$id": "a"}, {"$id": "b"}}
+	jst.Outgoing = []map[string]interface{}{{"$id": "out"}}
+	jst.Steps = []map[string]interface{}{
+		assignStep(10, "incoming", "a", "", "outgoing", "out", "/value"),
+		assignStep(2, "incoming", "b", "", "outgoing", "out", "/value"),
+	}
+
+	// step 2 runs before step 10 so the value assigned by step 10 is kept
+	res, err := Run(jst, map[string]interface{}{"a": "ten", "b": "two"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"out": map[string]interface{}{"value": "ten"}}, res)
+}
+
+func TestRunAssignArgsNotObject(t *testing.T) {
+	jst := services.NewTransformation("test", "")
+	jst.Incoming = []map[string]interface{}{{"$id": "in"}}
+	jst.Outgoing = []map[string]interface{}{{"$id": "out"}}
+	jst.Steps = []map[string]interface{}{
+		methodStep(1, "String", "toUpperCase", nil),
+		assignStep(10, "incoming", "in", "", "method", 1, ""),
+		assignStep(11, "method", 1, "/return", "outgoing", "out", ""),
+	}
+
+	_, err := Run(jst, map[string]interface{}{"in": "test"})
+	assert.ErrorContains(t, err, "step 1: arguments must be an object, got string")
+}
+
+func TestTemplateLiteral(t *testing.T) {
+	res := templateLiteral("${a}-${ b }-${a}-${c}", []interface{}{"x", float64(2)})
+	assert.Equal(t, "x-2-x-undefined", res)
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pointerTokens splits a JSON pointer into its unescaped reference tokens.
+// An empty pointer references the whole document.
+func pointerTokens(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("invalid pointer `%s`", ptr)
+	}
+
+	tokens := strings.Split(ptr[1:], "/")
+	for idx, ele := range tokens {
+		tokens[idx] = strings.NewReplacer("~1", "/", "~0", "~").Replace(ele)
+	}
+
+	return tokens, nil
+}
+
+// getPointer returns the value referenced by the pointer.  Missing object
+// keys and array indexes resolve to nil.
+func getPointer(doc any, ptr string) (any, error) {
+	tokens, err := pointerTokens(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tok := range tokens {
+		switch v := doc.(type) {
+		case map[string]interface{}:
+			doc = v[tok]
+		case []interface{}:
+			idx, err := strconv.Atoi(tok)
+			if err != nil {
+				return nil, fmt.Errorf("invalid array index `%s` in pointer `%s`", tok, ptr)
+			}
+			if idx < 0 || idx >= len(v) {
+				doc = nil
+			} else {
+				doc = v[idx]
+			}
+		case nil:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("unable to resolve pointer `%s`", ptr)
+		}
+	}
+
+	return doc, nil
+}
+
+// setPointer sets the value referenced by the pointer and returns the
+// updated document.  Intermediate objects and arrays are created as needed
+// and existing scalar values along the path are replaced.
+func setPointer(doc any, ptr string, value any) (any, error) {
+	tokens, err := pointerTokens(ptr)
+	if err != nil {
+		return nil, err
+	}
+	return setTokens(doc, tokens, value), nil
+}
+
+func setTokens(doc any, tokens []string, value any) any {
+	if len(tokens) == 0 {
+		return value
+	}
+
+	tok, rest := tokens[0], tokens[1:]
+	idx, err := strconv.Atoi(tok)
+	isIndex := err == nil && idx >= 0
+
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		v[tok] = setTokens(v[tok], rest, value)
+		return v
+	case []interface{}:
+		if tok == "-" {
+			return append(v, setTokens(nil, rest, value))
+		}
+		if isIndex {
+			for len(v) <= idx {
+				v = append(v, nil)
+			}
+			v[idx] = setTokens(v[idx], rest, value)
+			return v
+		}
+	case nil:
+		if isIndex || tok == "-" {
+			return setTokens([]interface{}{}, tokens, value)
+		}
+	}
+
+	return setTokens(map[string]interface{}{}, tokens, value)
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validate checks the value against the JSON schema and returns a list of
+// problems.  The path is used as the prefix of each problem.  The type,
+// enum, const, properties, required, additionalProperties, items,
+// minItems, maxItems, minLength, maxLength, pattern, minimum, maximum,
+// exclusiveMinimum and exclusiveMaximum keywords are checked.  All other
+// keywords are ignored.
+func Validate(schema map[string]interface{}, value any, path string) []string {
+	var problems []string
+
+	report := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf("%s %s", path, fmt.Sprintf(format, args...)))
+	}
+
+	if types := schemaTypes(schema["type"]); len(types) > 0 {
+		var matched bool
+		for _, ele := range types {
+			if matchesType(ele, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			report("must be of type %s, got %s", strings.Join(types, " or "), typeName(value))
+			return problems
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		var matched bool
+		for _, ele := range enum {
+			if reflect.DeepEqual(ele, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			report("must be one of %v", enum)
+		}
+	}
+
+	if c, exists := schema["const"]; exists && !reflect.DeepEqual(c, value) {
+		report("must be %v", c)
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if n, ok := schemaNumber(schema, "minLength"); ok && float64(length) < n {
+			report("must be at least %v characters", n)
+		}
+		if n, ok := schemaNumber(schema, "maxLength"); ok && float64(length) > n {
+			report("must be at most %v characters", n)
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			expr, err := regexp.Compile(pattern)
+			if err != nil {
+				report("has an invalid pattern `%s` in the schema", pattern)
+			} else if !expr.MatchString(v) {
+				report("must match the pattern `%s`", pattern)
+			}
+		}
+
+	case float64:
+		if n, ok := schemaNumber(schema, "minimum"); ok && v < n {
+			report("must be greater than or equal to %v", n)
+		}
+		if n, ok := schemaNumber(schema, "maximum"); ok && v > n {
+			report("must be less than or equal to %v", n)
+		}
+		if n, ok := schemaNumber(schema, "exclusiveMinimum"); ok && v <= n {
+			report("must be greater than %v", n)
+		}
+		if n, ok := schemaNumber(schema, "exclusiveMaximum"); ok && v >= n {
+			report("must be less than %v", n)
+		}
+
+	case []interface{}:
+		if n, ok := schemaNumber(schema, "minItems"); ok && float64(len(v)) < n {
+			report("must have at least %v items", n)
+		}
+		if n, ok := schemaNumber(schema, "maxItems"); ok && float64(len(v)) > n {
+			report("must have at most %v items", n)
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for idx, ele := range v {
+				problems = append(problems, Validate(items, ele, fmt.Sprintf("%s/%v", path, idx))...)
+			}
+		}
+
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, ele := range required {
+				if _, exists := v[fmt.Sprint(ele)]; !exists {
+					report("is missing required property `%v`", ele)
+				}
+			}
+		}
+
+		for _, key := range sortedKeys(v) {
+			if prop, ok := properties[key].(map[string]interface{}); ok {
+				problems = append(problems, Validate(prop, v[key], path+"/"+key)...)
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					report("has unexpected property `%s`", key)
+				}
+			case map[string]interface{}:
+				problems = append(problems, Validate(additional, v[key], path+"/"+key)...)
+			}
+		}
+	}
+
+	return problems
+}
+
+func schemaTypes(in any) []string {
+	switch v := in.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var res []string
+		for _, ele := range v {
+			res = append(res, fmt.Sprint(ele))
+		}
+		return res
+	}
+	return nil
+}
+
+func schemaNumber(schema map[string]interface{}, key string) (float64, bool) {
+	v, ok := schema[key].(float64)
+	return v, ok
+}
+
+func matchesType(t string, value any) bool {
+	switch t {
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	}
+	return typeName(value) == t
+}
+
+func typeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
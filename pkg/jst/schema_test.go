@@ -0,0 +1,56 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package jst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"name", "port"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "pattern": "^[a-z]+$"},
+			"port": map[string]interface{}{"type": "integer", "minimum": 1.0, "maximum": 65535.0},
+			"tags": map[string]interface{}{
+				"type":     "array",
+				"maxItems": 2.0,
+				"items":    map[string]interface{}{"type": "string", "enum": []interface{}{"a", "b"}},
+			},
+			"mode": map[string]interface{}{"type": []interface{}{"string", "null"}},
+		},
+		"additionalProperties": false,
+	}
+
+	assert.Empty(t, Validate(schema, map[string]interface{}{
+		"name": "web",
+		"port": 443.0,
+		"tags": []interface{}{"a"},
+		"mode": nil,
+	}, "device"))
+
+	assert.Equal(t, []string{
+		"device is missing required property `port`",
+		"device/name must match the pattern `^[a-z]+$`",
+		"device/tags must have at most 2 items",
+		"device/tags/2 must be one of [a b]",
+		"device has unexpected property `vlan`",
+	}, Validate(schema, map[string]interface{}{
+		"name": "Web",
+		"tags": []interface{}{"a", "b", "c"},
+		"vlan": 10.0,
+	}, "device"))
+
+	assert.Equal(t, []string{
+		"device/port must be of type integer, got number",
+	}, Validate(schema, map[string]interface{}{"name": "web", "port": 1.5}, "device"))
+
+	assert.Equal(t, []string{
+		"device must be of type object, got array",
+	}, Validate(schema, []interface{}{}, "device"))
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+// Package migrations converts exported assets between the document formats
+// used by different Itential Platform releases.
+//
+// Each supported release defines a set of transforms keyed by asset kind.
+// Migrate applies the transforms of every release after the source release
+// up to and including the target release, oldest first.  Documents are
+// changed in place and every field that is added, changed or removed is
+// returned as a Change so callers can report exactly what was migrated.
+//
+// Projects and automations embed other assets.  The transforms for those
+// kinds apply the transforms of the same release to the embedded workflow,
+// template and trigger documents.
+//
+// Only upgrades are supported.  Migrating to an older release returns an
+// error because fields introduced by a newer release can not be removed
+// safely.
+package migrations
@@ -0,0 +1,244 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package migrations
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itential/ipctl/internal/logging"
+)
+
+const (
+	KindWorkflow   = "workflow"
+	KindAutomation = "automation"
+	KindTrigger    = "trigger"
+	KindTemplate   = "template"
+	KindProject    = "project"
+)
+
+const (
+	ActionAdded   = "added"
+	ActionChanged = "changed"
+	ActionRemoved = "removed"
+)
+
+var releaseExpr = regexp.MustCompile(`(\d{4})\.(\d+)`)
+
+// Change describes a single field changed by a migration.  Path is the
+// location of the field in the document using dotted keys and [n] for list
+// indexes.  Old is nil for added fields and New is nil for removed fields.
+type Change struct {
+	Release string
+	Path    string
+	Action  string
+	Old     any
+	New     any
+}
+
+func (c Change) String() string {
+	switch c.Action {
+	case ActionAdded:
+		return fmt.Sprintf("[%s] %s: added %v", c.Release, c.Path, c.New)
+	case ActionRemoved:
+		return fmt.Sprintf("[%s] %s: removed", c.Release, c.Path)
+	}
+	return fmt.Sprintf("[%s] %s: %v -> %v", c.Release, c.Path, c.Old, c.New)
+}
+
+// transform changes a single document of one asset kind.
+type transform func(*step, map[string]interface{})
+
+// release holds the transforms required to move a document from the
+// previous release to this one.
+type release struct {
+	version    string
+	transforms map[string]transform
+}
+
+// step applies the transforms of a single release and records the changes
+// made to the document.
+type step struct {
+	release *release
+	prefix  string
+	changes []Change
+}
+
+// apply runs the transform for kind against doc.  Kinds without a transform
+// in the release are left unchanged.
+func (s *step) apply(kind string, doc map[string]interface{}) {
+	if fn, exists := s.release.transforms[kind]; exists {
+		fn(s, doc)
+	}
+}
+
+// within runs fn with every recorded path prefixed by path.
+func (s *step) within(path string, fn func()) {
+	saved := s.prefix
+	s.prefix = saved + path + "."
+	fn()
+	s.prefix = saved
+}
+
+func (s *step) record(key, action string, old, new any) {
+	s.changes = append(s.changes, Change{
+		Release: s.release.version,
+		Path:    s.prefix + key,
+		Action:  action,
+		Old:     old,
+		New:     new,
+	})
+}
+
+// set assigns value to key and records the change when the current value is
+// different.
+func (s *step) set(doc map[string]interface{}, key string, value any) {
+	old, exists := doc[key]
+	if exists && reflect.DeepEqual(old, value) {
+		return
+	}
+	doc[key] = value
+	if exists {
+		s.record(key, ActionChanged, old, value)
+	} else {
+		s.record(key, ActionAdded, nil, value)
+	}
+}
+
+// setDefault assigns value to key when the key is missing or null.
+func (s *step) setDefault(doc map[string]interface{}, key string, value any) {
+	if old, exists := doc[key]; exists && old != nil {
+		return
+	}
+	s.set(doc, key, value)
+}
+
+// remove deletes key from the document when it exists.
+func (s *step) remove(doc map[string]interface{}, key string) {
+	if old, exists := doc[key]; exists {
+		delete(doc, key)
+		s.record(key, ActionRemoved, old, nil)
+	}
+}
+
+// Kinds returns the asset kinds that can be migrated.
+func Kinds() []string {
+	return []string{KindWorkflow, KindAutomation, KindTrigger, KindTemplate, KindProject}
+}
+
+// Supported returns true if documents of kind can be migrated.
+func Supported(kind string) bool {
+	for _, ele := range Kinds() {
+		if ele == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Versions returns the releases known to this package, oldest first.
+func Versions() []string {
+	var res []string
+	for _, ele := range releases {
+		res = append(res, ele.version)
+	}
+	return res
+}
+
+// Release returns the release, in the form <year>.<minor>, of a platform
+// version string.  Full versions such as `2023.2.9` and application versions
+// such as `5.55.2-2023.2.13` are accepted.
+func Release(version string) (string, error) {
+	matches := releaseExpr.FindAllStringSubmatch(version, -1)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("unable to determine the platform release from version `%s`", version)
+	}
+	match := matches[len(matches)-1]
+	minor, _ := strconv.Atoi(match[2])
+	return fmt.Sprintf("%s.%d", match[1], minor), nil
+}
+
+// releaseIndex returns the position of the release for version in the
+// list of known releases.
+func releaseIndex(version string) (int, error) {
+	rel, err := Release(version)
+	if err != nil {
+		return -1, err
+	}
+	for idx, ele := range releases {
+		if ele.version == rel {
+			return idx, nil
+		}
+	}
+	return -1, fmt.Errorf(
+		"unsupported platform release `%s`, must be one of %s",
+		rel, strings.Join(Versions(), ", "),
+	)
+}
+
+// Required returns true if documents exported from the release of version
+// from must be migrated before they are imported into the release of
+// version to.
+func Required(from, to string) (bool, error) {
+	src, err := releaseIndex(from)
+	if err != nil {
+		return false, err
+	}
+	dst, err := releaseIndex(to)
+	if err != nil {
+		return false, err
+	}
+	if src > dst {
+		return false, fmt.Errorf("migrating assets from %s to %s is not supported", releases[src].version, releases[dst].version)
+	}
+	return src < dst, nil
+}
+
+// Migrate converts doc, a document of the specified kind exported from the
+// release of version from, to the format of the release of version to.  The
+// document is changed in place and the changes are returned in the order
+// they were made.
+func Migrate(kind string, doc map[string]interface{}, from, to string) ([]Change, error) {
+	logging.Trace()
+
+	if !Supported(kind) {
+		return nil, fmt.Errorf("unsupported asset kind `%s`", kind)
+	}
+
+	if _, err := Required(from, to); err != nil {
+		return nil, err
+	}
+
+	src, _ := releaseIndex(from)
+	dst, _ := releaseIndex(to)
+
+	var changes []Change
+
+	for idx := src + 1; idx <= dst; idx++ {
+		s := &step{release: &releases[idx]}
+		s.apply(kind, doc)
+		changes = append(changes, s.changes...)
+	}
+
+	return changes, nil
+}
+
+// number returns the value as a float64 and true if it is numeric.
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
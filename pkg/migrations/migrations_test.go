@@ -0,0 +1,141 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelease(t *testing.T) {
+	for version, expected := range map[string]string{
+		"2023.1":           "2023.1",
+		"2023.2.9":         "2023.2",
+		"5.55.2-2023.2.13": "2023.2",
+		"2023.02":          "2023.2",
+	} {
+		res, err := Release(version)
+		require.NoError(t, err)
+		assert.Equal(t, expected, res, version)
+	}
+
+	_, err := Release("latest")
+	assert.EqualError(t, err, "unable to determine the platform release from version `latest`")
+}
+
+func TestRequired(t *testing.T) {
+	required, err := Required("2023.1.4", "2023.2.9")
+	require.NoError(t, err)
+	assert.True(t, required)
+
+	required, err = Required("2023.2.1", "2023.2.9")
+	require.NoError(t, err)
+	assert.False(t, required)
+
+	_, err = Required("2023.2", "2023.1")
+	assert.EqualError(t, err, "migrating assets from 2023.2 to 2023.1 is not supported")
+
+	_, err = Required("2021.1", "2023.2")
+	assert.EqualError(t, err, "unsupported platform release `2021.1`, must be one of 2023.1, 2023.2")
+}
+
+func TestMigrateWorkflow(t *testing.T) {
+	doc := map[string]interface{}{
+		"name":          "backup",
+		"canvasVersion": float64(2),
+		"errors":        []interface{}{},
+	}
+
+	changes, err := Migrate(KindWorkflow, doc, "2023.1", "2023.2")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"name":            "backup",
+		"canvasVersion":   float64(3),
+		"encodingVersion": float64(1),
+		"type":            "automation",
+	}, doc)
+
+	var report []string
+	for _, ele := range changes {
+		report = append(report, ele.String())
+	}
+
+	assert.Equal(t, []string{
+		"[2023.2] canvasVersion: 2 -> 3",
+		"[2023.2] encodingVersion: added 1",
+		"[2023.2] type: added automation",
+		"[2023.2] errors: removed",
+	}, report)
+
+	// migrating a document that is already current makes no changes
+	changes, err = Migrate(KindWorkflow, doc, "2023.1", "2023.2")
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestMigrateAutomation(t *testing.T) {
+	doc := map[string]interface{}{
+		"name": "backup",
+		"triggers": []interface{}{
+			map[string]interface{}{"name": "manual", "migrationVersion": float64(3)},
+			map[string]interface{}{"name": "nightly", "migrationVersion": float64(2)},
+		},
+	}
+
+	changes, err := Migrate(KindAutomation, doc, "2023.1", "2023.2")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "gbac", changes[0].Path)
+	assert.Equal(t, ActionAdded, changes[0].Action)
+	assert.Equal(t, Change{
+		Release: "2023.2",
+		Path:    "triggers[1].migrationVersion",
+		Action:  ActionChanged,
+		Old:     float64(2),
+		New:     float64(3),
+	}, changes[1])
+}
+
+func TestMigrateProject(t *testing.T) {
+	doc := map[string]interface{}{
+		"name": "Provisioning",
+		"components": []interface{}{
+			map[string]interface{}{
+				"type":     "transformation",
+				"document": map[string]interface{}{"name": "jst"},
+			},
+			map[string]interface{}{
+				"type": "template",
+				"document": map[string]interface{}{
+					"name": "show version",
+					"type": "jinja2",
+					"tags": []interface{}{},
+				},
+			},
+			map[string]interface{}{
+				"type": "workflow",
+				"document": map[string]interface{}{
+					"name":            "backup",
+					"canvasVersion":   float64(3),
+					"encodingVersion": float64(1),
+				},
+			},
+		},
+	}
+
+	changes, err := Migrate(KindProject, doc, "2023.1", "2023.2")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "components[2].document.type", changes[0].Path)
+}
+
+func TestMigrateUnsupported(t *testing.T) {
+	_, err := Migrate("adapter", map[string]interface{}{}, "2023.1", "2023.2")
+	assert.EqualError(t, err, "unsupported asset kind `adapter`")
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// canvasVersion2023_2 is the workflow canvas version used by 2023.2
+	canvasVersion2023_2 = 3
+
+	// encodingVersion2023_2 is the workflow encoding version used by 2023.2
+	encodingVersion2023_2 = 1
+
+	// triggerMigrationVersion2023_2 is the trigger migration version used
+	// by 2023.2
+	triggerMigrationVersion2023_2 = 3
+)
+
+// releases lists every supported release, oldest first.  The first release
+// is the baseline and has no transforms.  New releases are appended to the
+// end of the list with the transforms required to upgrade documents from
+// the release before it.
+var releases = []release{
+	{version: "2023.1"},
+	{
+		version: "2023.2",
+		transforms: map[string]transform{
+			KindWorkflow:   workflow2023_2,
+			KindAutomation: automation2023_2,
+			KindTrigger:    trigger2023_2,
+			KindTemplate:   template2023_2,
+			KindProject:    project,
+		},
+	},
+}
+
+// workflow2023_2 upgrades a workflow to the canvas and encoding versions
+// used by 2023.2.  The validation errors stored with the workflow are
+// computed by the server and are removed so they are recalculated on
+// import.
+func workflow2023_2(s *step, doc map[string]interface{}) {
+	if v, ok := number(doc["canvasVersion"]); !ok || v < canvasVersion2023_2 {
+		s.set(doc, "canvasVersion", float64(canvasVersion2023_2))
+	}
+	s.setDefault(doc, "encodingVersion", float64(encodingVersion2023_2))
+	s.setDefault(doc, "type", "automation")
+	s.remove(doc, "errors")
+}
+
+// automation2023_2 adds the gbac field required by 2023.2 and upgrades the
+// triggers embedded in the automation.
+func automation2023_2(s *step, doc map[string]interface{}) {
+	s.setDefault(doc, "gbac", map[string]interface{}{
+		"read":  []interface{}{},
+		"write": []interface{}{},
+	})
+	if triggers, ok := doc["triggers"].([]interface{}); ok {
+		for idx, ele := range triggers {
+			if trigger, ok := ele.(map[string]interface{}); ok {
+				s.within(fmt.Sprintf("triggers[%d]", idx), func() {
+					s.apply(KindTrigger, trigger)
+				})
+			}
+		}
+	}
+}
+
+// trigger2023_2 upgrades a trigger to the migration version used by 2023.2.
+func trigger2023_2(s *step, doc map[string]interface{}) {
+	if v, ok := number(doc["migrationVersion"]); !ok || v < triggerMigrationVersion2023_2 {
+		s.set(doc, "migrationVersion", float64(triggerMigrationVersion2023_2))
+	}
+}
+
+// template2023_2 sets the template type, older releases only supported
+// TextFSM templates and did not store the type, and adds the tags field.
+func template2023_2(s *step, doc map[string]interface{}) {
+	s.setDefault(doc, "type", "textfsm")
+	s.setDefault(doc, "tags", []interface{}{})
+}
+
+// project applies the transforms of the current release to the documents of
+// the project components.  It is used by every release because projects
+// only change through the assets they contain.
+func project(s *step, doc map[string]interface{}) {
+	components, ok := doc["components"].([]interface{})
+	if !ok {
+		return
+	}
+	for idx, ele := range components {
+		component, ok := ele.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _ := component["type"].(string)
+		document, ok := component["document"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		s.within(fmt.Sprintf("components[%d].document", idx), func() {
+			s.apply(strings.ToLower(kind), document)
+		})
+	}
+}
@@ -5,8 +5,6 @@
 package resources
 
 import (
-	"fmt"
-
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/services"
 )
@@ -26,7 +24,7 @@ func NewConfigurationTemplateResource(svc services.ConfigurationTemplateServicer
 }
 
 // GetByName retrieves a configuration template by name using client-side filtering.
-// It fetches all templates and searches for a matching name.
+// It fetches all templates and searches for an exact name match.
 func (r *ConfigurationTemplateResource) GetByName(name string) (*services.ConfigurationTemplate, error) {
 	logging.Trace()
 
@@ -35,13 +33,49 @@ func (r *ConfigurationTemplateResource) GetByName(name string) (*services.Config
 		return nil, err
 	}
 
-	// Use service.Get to fetch full template details after finding by name
-	for _, template := range templates {
-		if template.Name == name {
-			return r.service.Get(template.Id)
-		}
-	}
+	return FindByName(templates, "configuration template", name, func(t services.ConfigurationTemplate) string {
+		return t.Name
+	})
+}
+
+// GetAll retrieves all configuration templates from the API.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) GetAll() ([]services.ConfigurationTemplate, error) {
+	return r.service.GetAll()
+}
+
+// Get retrieves a configuration template by its ID from the API.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Get(id string) (*services.ConfigurationTemplate, error) {
+	return r.service.Get(id)
+}
+
+// Create creates a new configuration template.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Create(in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error) {
+	return r.service.Create(in)
+}
+
+// Update replaces an existing configuration template.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Update(id string, in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error) {
+	return r.service.Update(id, in)
+}
+
+// Delete removes a configuration template by its ID.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Delete(id string) error {
+	return r.service.Delete(id)
+}
+
+// Import imports a configuration template into the system.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Import(in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error) {
+	return r.service.Import(in)
+}
 
-	logging.Error(nil, "configuration template not found")
-	return nil, fmt.Errorf("configuration template not found")
+// Export exports a configuration template by its ID.
+// This is a pass-through to the service layer for pure API access.
+func (r *ConfigurationTemplateResource) Export(id string) (*services.ConfigurationTemplate, error) {
+	return r.service.Export(id)
 }
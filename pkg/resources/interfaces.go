@@ -60,7 +60,14 @@ type AutomationResourcer interface {
 // ConfigurationTemplateResourcer defines operations for configuration template business logic.
 // It handles template management with business rules applied.
 type ConfigurationTemplateResourcer interface {
+	GetAll() ([]services.ConfigurationTemplate, error)
+	Get(id string) (*services.ConfigurationTemplate, error)
 	GetByName(name string) (*services.ConfigurationTemplate, error)
+	Create(in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error)
+	Update(id string, in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error)
+	Delete(id string) error
+	Import(in services.ConfigurationTemplate) (*services.ConfigurationTemplate, error)
+	Export(id string) (*services.ConfigurationTemplate, error)
 }
 
 // DeviceGroupResourcer defines operations for device group business logic.
@@ -86,6 +93,7 @@ type JsonFormResourcer interface {
 	Get(id string) (*services.JsonForm, error)
 	GetByName(name string) (*services.JsonForm, error)
 	Create(in services.JsonForm) (*services.JsonForm, error)
+	Update(in services.JsonForm) (*services.JsonForm, error)
 	Delete(ids []string) error
 	Import(in services.JsonForm) (*services.JsonForm, error)
 	Clear() error
@@ -126,6 +134,7 @@ type TemplateResourcer interface {
 	Get(id string) (*services.Template, error)
 	GetByName(name string) (*services.Template, error)
 	Create(in services.Template) (*services.Template, error)
+	Update(in services.Template) (*services.Template, error)
 	Delete(id string) error
 	Import(in services.Template) (*services.Template, error)
 	Export(id string) (*services.Template, error)
@@ -138,6 +147,7 @@ type TransformationResourcer interface {
 	Get(name string) (*services.Transformation, error)
 	GetByName(name string) (*services.Transformation, error)
 	Create(in services.Transformation) (*services.Transformation, error)
+	Update(in services.Transformation) (*services.Transformation, error)
 	Delete(id string) error
 	Import(in services.Transformation) (*services.Transformation, error)
 	Clear() error
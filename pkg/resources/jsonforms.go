@@ -78,6 +78,12 @@ func (r *JsonFormResource) Create(in services.JsonForm) (*services.JsonForm, err
 	return r.service.Create(in)
 }
 
+// Update replaces an existing JSON form.
+// This is a pass-through to the service layer for pure API access.
+func (r *JsonFormResource) Update(in services.JsonForm) (*services.JsonForm, error) {
+	return r.service.Update(in)
+}
+
 // Delete removes one or more JSON forms by their IDs.
 // This is a pass-through to the service layer for pure API access.
 func (r *JsonFormResource) Delete(ids []string) error {
@@ -56,6 +56,12 @@ func (r *TemplateResource) Create(in services.Template) (*services.Template, err
 	return r.service.Create(in)
 }
 
+// Update replaces an existing template.
+// This is a pass-through to the service layer for pure API access.
+func (r *TemplateResource) Update(in services.Template) (*services.Template, error) {
+	return r.service.Update(in)
+}
+
 // Delete removes a template by its ID.
 // This is a pass-through to the service layer for pure API access.
 func (r *TemplateResource) Delete(id string) error {
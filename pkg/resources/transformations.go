@@ -44,6 +44,12 @@ func (r *TransformationResource) Create(in services.Transformation) (*services.T
 	return r.service.Create(in)
 }
 
+// Update replaces an existing transformation.
+// This is a pass-through to the service layer for pure API access.
+func (r *TransformationResource) Update(in services.Transformation) (*services.Transformation, error) {
+	return r.service.Update(in)
+}
+
 // Delete removes a transformation by its identifier.
 // This is a pass-through to the service layer for pure API access.
 func (r *TransformationResource) Delete(id string) error {
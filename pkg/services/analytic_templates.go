@@ -40,6 +40,34 @@ type AnalyticTemplate struct {
 	LastUpdatedBy   string                    `json:"lastUpdatedBy"`
 }
 
+// AnalyticTemplateRuleResult is the evaluation result of a single analytic
+// template rule
+type AnalyticTemplateRuleResult struct {
+	Type      string `json:"type"`
+	PreRegex  string `json:"preRegex"`
+	PostRegex string `json:"postRegex"`
+	Evaluator string `json:"evaluator"`
+	Severity  string `json:"severity"`
+	Result    bool   `json:"result"`
+}
+
+// AnalyticTemplateCommandResult is the evaluation result of a pre and post
+// command pair
+type AnalyticTemplateCommandResult struct {
+	PreRawCommand  string                       `json:"preRawCommand"`
+	PostRawCommand string                       `json:"postRawCommand"`
+	Result         bool                         `json:"result"`
+	Rules          []AnalyticTemplateRuleResult `json:"rules"`
+}
+
+// AnalyticTemplateRunResult is the result of running an analytic template
+// against pre and post command output
+type AnalyticTemplateRunResult struct {
+	Name            string                          `json:"name"`
+	Result          bool                            `json:"result"`
+	PrePostCommands []AnalyticTemplateCommandResult `json:"prepostCommands"`
+}
+
 type AnalyticTemplateService struct {
 	BaseService
 }
@@ -173,3 +201,27 @@ func (svc *AnalyticTemplateService) Export(name string) (*AnalyticTemplate, erro
 	}
 	return res, nil
 }
+
+// Run calls `POST /mop/runAnalyticsTemplate` to evaluate the named analytic
+// template against the captured pre and post command output
+func (svc *AnalyticTemplateService) Run(name, pre, post string) (*AnalyticTemplateRunResult, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"analyticTemplateName": name,
+		"pre":                  map[string]interface{}{"response": pre},
+		"post":                 map[string]interface{}{"response": post},
+	}
+
+	var res *AnalyticTemplateRunResult
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/mop/runAnalyticsTemplate",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -5,6 +5,7 @@
 package services
 
 import (
+	"encoding/json"
 	"net/http"
 	"path/filepath"
 	"testing"
@@ -95,3 +96,34 @@ func TestNewAnalyticTemplateHasEmptyTags(t *testing.T) {
 	at := NewAnalyticTemplate("test")
 	assert.Equal(t, []Tag{}, at.Tags)
 }
+
+func TestAnalyticTemplateService_Run(t *testing.T) {
+	svc := setupAnalyticTemplateService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/mop/runAnalyticsTemplate", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "version-check",
+			"result": true,
+			"prepostCommands": [{
+				"preRawCommand": "show version",
+				"postRawCommand": "show version",
+				"result": true,
+				"rules": [{"type": "regex", "preRegex": "Version (\\S+)", "postRegex": "Version (\\S+)", "evaluator": "=", "severity": "error", "result": true}]
+			}]
+		}`))
+	})
+
+	res, err := svc.Run("version-check", "Version 15.1", "Version 15.1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "version-check", body["analyticTemplateName"])
+	assert.Equal(t, map[string]interface{}{"response": "Version 15.1"}, body["pre"])
+	assert.True(t, res.Result)
+	assert.Equal(t, 1, len(res.PrePostCommands))
+	assert.Equal(t, "=", res.PrePostCommands[0].Rules[0].Evaluator)
+}
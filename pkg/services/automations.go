@@ -129,6 +129,41 @@ func (svc *AutomationService) Create(in Automation) (*Automation, error) {
 	return res.Data, nil
 }
 
+// Update implements `PATCH /operations-manager/automations/{id}`
+func (svc *AutomationService) Update(in Automation) (*Automation, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"name":          in.Name,
+		"description":   in.Description,
+		"componentType": in.ComponentType,
+		"componentName": in.ComponentName,
+	}
+
+	if in.ComponentId != "" {
+		body["componentId"] = in.ComponentId
+	}
+
+	type Response struct {
+		Message string      `json:"message"`
+		Data    *Automation `json:"data"`
+	}
+
+	var res Response
+
+	if err := svc.PatchRequest(&Request{
+		uri:                fmt.Sprintf("/operations-manager/automations/%s", in.Id),
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	logging.Info("%s", res.Message)
+
+	return res.Data, nil
+}
+
 // Delete implements `DELETE /operations-manager/automations/{id}`
 func (svc *AutomationService) Delete(id string) error {
 	logging.Trace()
@@ -150,3 +150,23 @@ func TestNewAutomation(t *testing.T) {
 	assert.Equal(t, "Description", automation.Description)
 	assert.Equal(t, "workflows", automation.ComponentType)
 }
+
+func TestAutomationUpdate(t *testing.T) {
+	svc := setupAutomationService()
+	defer testlib.Teardown()
+
+	testlib.AddPatchResponseToMux(
+		"/operations-manager/automations/auto1",
+		`{"message": "updated", "data": {"_id": "auto1", "name": "test", "componentName": "renamed"}}`,
+		0,
+	)
+
+	in := NewAutomation("test", "")
+	in.Id = "auto1"
+	in.ComponentName = "renamed"
+
+	res, err := svc.Update(in)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", res.ComponentName)
+}
@@ -40,6 +40,34 @@ type CommandTemplate struct {
 	Namespace      any                      `json:"namespace"`
 }
 
+// CommandTemplateRuleResult is the evaluation result of a single rule
+type CommandTemplateRuleResult struct {
+	Rule     string `json:"rule"`
+	Eval     string `json:"eval"`
+	Severity string `json:"severity"`
+	Result   bool   `json:"result"`
+}
+
+// CommandTemplateCommandResult is the result of running a single command
+// from a command template against a device
+type CommandTemplateCommandResult struct {
+	Raw         string                      `json:"raw"`
+	Evaluated   string                      `json:"evaluated"`
+	Device      string                      `json:"device"`
+	Response    any                         `json:"response"`
+	Result      bool                        `json:"result"`
+	AllPassFlag bool                        `json:"all_pass_flag"`
+	Rules       []CommandTemplateRuleResult `json:"rules"`
+}
+
+// CommandTemplateRunResult is the result of running a command template
+type CommandTemplateRunResult struct {
+	Name            string                         `json:"name"`
+	Result          bool                           `json:"result"`
+	AllPassFlag     bool                           `json:"all_pass_flag"`
+	CommandsResults []CommandTemplateCommandResult `json:"commands_results"`
+}
+
 type CommandTemplateService struct {
 	BaseService
 }
@@ -224,3 +252,35 @@ func (svc *CommandTemplateService) Export(name string) (*CommandTemplate, error)
 
 	return res, nil
 }
+
+// Run calls `POST /mop/RunCommandTemplate` to run the named command template
+// against one or more devices and returns the evaluated results
+func (svc *CommandTemplateService) Run(name string, devices []string, variables map[string]interface{}) (*CommandTemplateRunResult, error) {
+	logging.Trace()
+
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	body := map[string]interface{}{
+		"template":  name,
+		"devices":   devices,
+		"variables": variables,
+	}
+
+	var res *CommandTemplateRunResult
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/mop/RunCommandTemplate",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("command template `%s` did not return a result", name)
+	}
+
+	return res, nil
+}
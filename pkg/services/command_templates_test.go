@@ -5,6 +5,7 @@
 package services
 
 import (
+	"encoding/json"
 	"net/http"
 	"path/filepath"
 	"testing"
@@ -95,3 +96,53 @@ func TestNewCommandTemplateHasEmptyTags(t *testing.T) {
 	ct := NewCommandTemplate("test")
 	assert.Equal(t, []Tag{}, ct.Tags)
 }
+
+func TestCommandTemplateService_Run(t *testing.T) {
+	svc := setupCommandTemplateService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/mop/RunCommandTemplate", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"name": "pre-check",
+			"result": false,
+			"all_pass_flag": true,
+			"commands_results": [{
+				"raw": "show version",
+				"evaluated": "show version",
+				"device": "rtr1",
+				"response": "Cisco IOS",
+				"result": false,
+				"rules": [
+					{"rule": "IOS", "eval": "contains", "severity": "error", "result": true},
+					{"rule": "15.2", "eval": "contains", "severity": "warning", "result": false}
+				]
+			}]
+		}`))
+	})
+
+	res, err := svc.Run("pre-check", []string{"rtr1"}, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "pre-check", body["template"])
+	assert.Equal(t, []interface{}{"rtr1"}, body["devices"])
+	assert.Equal(t, map[string]interface{}{}, body["variables"])
+	assert.Equal(t, 1, len(res.CommandsResults))
+	assert.Equal(t, 2, len(res.CommandsResults[0].Rules))
+	assert.False(t, res.CommandsResults[0].Rules[1].Result)
+}
+
+func TestCommandTemplateService_RunNullResult(t *testing.T) {
+	svc := setupCommandTemplateService()
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux("/mop/RunCommandTemplate", `null`, http.StatusOK)
+
+	res, err := svc.Run("pre-check", []string{"rtr1"}, nil)
+
+	assert.EqualError(t, err, "command template `pre-check` did not return a result")
+	assert.Nil(t, res)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/itential/ipctl/internal/logging"
+)
+
+// ComplianceRun describes a compliance check to run against a golden
+// configuration tree node.  When Devices is empty, the check runs against the
+// devices attached to the node.
+type ComplianceRun struct {
+	TreeId   string   `json:"treeId"`
+	Version  string   `json:"version"`
+	NodePath string   `json:"nodePath"`
+	Devices  []string `json:"devices,omitempty"`
+}
+
+// ComplianceIssue is a single violation found by a compliance check
+type ComplianceIssue struct {
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Line     string `json:"line"`
+}
+
+// ComplianceReport is the result of a compliance check for a single device
+type ComplianceReport struct {
+	Id         string            `json:"id"`
+	BatchId    string            `json:"batchId"`
+	DeviceName string            `json:"deviceName"`
+	TreeId     string            `json:"treeId"`
+	Version    string            `json:"version"`
+	NodePath   string            `json:"nodePath"`
+	Status     string            `json:"status"`
+	Errors     int               `json:"errors"`
+	Warnings   int               `json:"warnings"`
+	Passes     int               `json:"passes"`
+	Issues     []ComplianceIssue `json:"issues"`
+	Timestamp  string            `json:"timestamp"`
+}
+
+// RunCompliance calls `POST /configuration_manager/compliance_reports/run`
+// and returns the batch id that identifies the compliance reports.
+func (svc *GoldenConfigService) RunCompliance(in ComplianceRun) (string, error) {
+	logging.Trace()
+
+	type Response struct {
+		BatchId string `json:"batchId"`
+	}
+
+	var res Response
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/configuration_manager/compliance_reports/run",
+		body:               &in,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return "", err
+	}
+
+	if res.BatchId == "" {
+		return "", errors.New("compliance run did not return a batch id")
+	}
+
+	return res.BatchId, nil
+}
+
+// GetComplianceReports calls `POST /configuration_manager/compliance_reports/batch`
+// and returns the reports that belong to the batch.
+func (svc *GoldenConfigService) GetComplianceReports(batchId string) ([]ComplianceReport, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{"batchId": batchId}
+
+	var res []ComplianceReport
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/configuration_manager/compliance_reports/batch",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetComplianceReport calls `GET /configuration_manager/compliance_reports/details/{id}`
+// and returns the report including the list of issues.
+func (svc *GoldenConfigService) GetComplianceReport(id string) (*ComplianceReport, error) {
+	logging.Trace()
+
+	var res *ComplianceReport
+	var uri = fmt.Sprintf("/configuration_manager/compliance_reports/details/%s", id)
+
+	if err := svc.BaseService.Get(uri, &res); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errors.New("compliance report not found")
+	}
+
+	return res, nil
+}
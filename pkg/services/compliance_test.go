@@ -0,0 +1,90 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupGoldenConfigService() *GoldenConfigService {
+	return NewGoldenConfigService(testlib.Setup())
+}
+
+func TestGoldenConfigService_RunCompliance(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	var body ComplianceRun
+
+	testlib.AddHandlerToMux("/configuration_manager/compliance_reports/run", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"batchId": "batch1"}`))
+	})
+
+	res, err := svc.RunCompliance(ComplianceRun{
+		TreeId:   "tree1",
+		Version:  "initial",
+		NodePath: "base",
+		Devices:  []string{"rtr1"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "batch1", res)
+	assert.Equal(t, "tree1", body.TreeId)
+	assert.Equal(t, []string{"rtr1"}, body.Devices)
+}
+
+func TestGoldenConfigService_RunComplianceNoBatch(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux("/configuration_manager/compliance_reports/run", `{}`, http.StatusOK)
+
+	_, err := svc.RunCompliance(ComplianceRun{TreeId: "tree1"})
+
+	assert.NotNil(t, err)
+}
+
+func TestGoldenConfigService_GetComplianceReports(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/compliance_reports/batch",
+		`[{"id": "r1", "batchId": "batch1", "deviceName": "rtr1", "status": "complete", "errors": 1}]`,
+		http.StatusOK,
+	)
+
+	res, err := svc.GetComplianceReports("batch1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "rtr1", res[0].DeviceName)
+	assert.Equal(t, 1, res[0].Errors)
+}
+
+func TestGoldenConfigService_GetComplianceReport(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/compliance_reports/details/r1",
+		`{"id": "r1", "deviceName": "rtr1", "issues": [{"type": "missing", "severity": "error", "message": "line is missing", "line": "ntp server 10.0.0.1"}]}`,
+		0,
+	)
+
+	res, err := svc.GetComplianceReport("r1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.Issues))
+	assert.Equal(t, "ntp server 10.0.0.1", res.Issues[0].Line)
+}
@@ -5,6 +5,8 @@
 package services
 
 import (
+	"net/http"
+
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/client"
 )
@@ -23,11 +25,28 @@ func NewConfigManagerService(c client.Client) *ConfigManagerService {
 	return &ConfigManagerService{BaseService: NewBaseService(c)}
 }
 
-func (svc *ConfigManagerService) Render(in ConfigManagerJinja2Template) {
+// Render calls `POST /configuration_manager/jinja2` and returns the rendered
+// template text
+func (svc *ConfigManagerService) Render(in ConfigManagerJinja2Template) (string, error) {
 	logging.Trace()
 
-	if err := svc.Post("/configuration_manager/jinja2", in, nil); err != nil {
-		panic(err)
+	if in.Variables == nil {
+		in.Variables = map[string]interface{}{}
+	}
+
+	if in.Options == nil {
+		in.Options = map[string]interface{}{}
+	}
+
+	var res string
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/configuration_manager/jinja2",
+		body:               &in,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return "", err
 	}
 
+	return res, nil
 }
@@ -0,0 +1,57 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConfigManagerService() *ConfigManagerService {
+	return NewConfigManagerService(
+		testlib.Setup(),
+	)
+}
+
+func TestConfigManagerService_Render(t *testing.T) {
+	svc := setupConfigManagerService()
+	defer testlib.Teardown()
+
+	var body ConfigManagerJinja2Template
+
+	testlib.AddHandlerToMux("/configuration_manager/jinja2", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode("hostname bar")
+	})
+
+	res, err := svc.Render(ConfigManagerJinja2Template{
+		Template:  "hostname {{ foo }}",
+		Variables: map[string]interface{}{"foo": "bar"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "hostname bar", res)
+	assert.Equal(t, "hostname {{ foo }}", body.Template)
+	assert.Equal(t, "bar", body.Variables["foo"])
+	assert.NotNil(t, body.Options)
+}
+
+func TestConfigManagerService_RenderError(t *testing.T) {
+	svc := setupConfigManagerService()
+	defer testlib.Teardown()
+
+	testlib.AddPostErrorToMux("/configuration_manager/jinja2", `{"message": "template error"}`, 0)
+
+	res, err := svc.Render(ConfigManagerJinja2Template{Template: "{{ foo"})
+
+	assert.NotNil(t, err)
+	assert.Empty(t, res)
+}
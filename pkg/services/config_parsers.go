@@ -5,17 +5,21 @@
 package services
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
+
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/client"
 )
 
 type ConfigurationParser struct {
-	Id       string     `json:"id"`
+	Id       string     `json:"id,omitempty"`
 	Name     string     `json:"name"`
 	Template string     `json:"template"`
 	LexRules [][]string `json:"lexRules"`
-	Updated  any        `json:"updated"`
-	Created  any        `json:"created"`
+	Updated  any        `json:"updated,omitempty"`
+	Created  any        `json:"created,omitempty"`
 	Gbac     Gbac       `json:"gbac"`
 }
 
@@ -25,7 +29,7 @@ type ConfigurationParserService struct {
 
 func NewConfigurationParser(name string) ConfigurationParser {
 	logging.Trace()
-	return ConfigurationParser{Name: name}
+	return ConfigurationParser{Name: name, LexRules: [][]string{}}
 }
 
 func NewConfigurationParserService(c client.Client) *ConfigurationParserService {
@@ -48,3 +52,65 @@ func (svc *ConfigurationParserService) GetAll() ([]ConfigurationParser, error) {
 
 	return res.List, nil
 }
+
+// Get calls `GET /configuration_manager/configurations/parser/{name}` and
+// returns the configuration parser with the specified name
+func (svc *ConfigurationParserService) Get(name string) (*ConfigurationParser, error) {
+	logging.Trace()
+
+	var res *ConfigurationParser
+	var uri = fmt.Sprintf("/configuration_manager/configurations/parser/%s", url.PathEscape(name))
+
+	if err := svc.BaseService.Get(uri, &res); err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.Name == "" {
+		return nil, fmt.Errorf("configuration parser `%s` not found", name)
+	}
+
+	return res, nil
+}
+
+// Create calls `POST /configuration_manager/configurations/parser` to create
+// a new configuration parser on the server
+func (svc *ConfigurationParserService) Create(in ConfigurationParser) (*ConfigurationParser, error) {
+	logging.Trace()
+
+	lexRules := in.LexRules
+	if lexRules == nil {
+		lexRules = [][]string{}
+	}
+
+	body := map[string]interface{}{
+		"name":     in.Name,
+		"template": in.Template,
+		"lexRules": lexRules,
+	}
+
+	var res *ConfigurationParser
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/configuration_manager/configurations/parser",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Delete calls `DELETE /configuration_manager/configurations/parser` to remove
+// the configuration parser with the specified name from the server
+func (svc *ConfigurationParserService) Delete(name string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{"name": name}
+
+	return svc.DeleteRequest(&Request{
+		uri:                "/configuration_manager/configurations/parser",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupConfigurationParserService() *ConfigurationParserService {
+	return NewConfigurationParserService(
+		testlib.Setup(),
+	)
+}
+
+func TestConfigurationParserService_GetAll(t *testing.T) {
+	svc := setupConfigurationParserService()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/configurations/parser",
+		`{"list": [{"id": "1", "name": "cisco-ios", "lexRules": [["^interface", "interface"]]}], "total": 1}`,
+		0,
+	)
+
+	res, err := svc.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "cisco-ios", res[0].Name)
+	assert.Equal(t, [][]string{{"^interface", "interface"}}, res[0].LexRules)
+}
+
+func TestConfigurationParserService_Get(t *testing.T) {
+	svc := setupConfigurationParserService()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/configurations/parser/cisco-ios",
+		`{"id": "1", "name": "cisco-ios", "template": "cisco", "lexRules": []}`,
+		0,
+	)
+
+	res, err := svc.Get("cisco-ios")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cisco-ios", res.Name)
+	assert.Equal(t, "cisco", res.Template)
+}
+
+func TestConfigurationParserService_Create(t *testing.T) {
+	svc := setupConfigurationParserService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/configurations/parser", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "1", "name": "cisco-ios", "lexRules": []}`))
+	})
+
+	res, err := svc.Create(ConfigurationParser{Id: "old", Name: "cisco-ios"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1", res.Id)
+	assert.Equal(t, "cisco-ios", body["name"])
+	assert.Equal(t, []interface{}{}, body["lexRules"])
+	assert.NotContains(t, body, "id")
+}
+
+func TestConfigurationParserService_Delete(t *testing.T) {
+	svc := setupConfigurationParserService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/configurations/parser", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "success"}`))
+	})
+
+	err := svc.Delete("cisco-ios")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cisco-ios", body["name"])
+}
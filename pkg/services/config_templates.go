@@ -24,6 +24,8 @@ type ConfigTemplate struct {
 	DeviceOsTypes []string               `json:"deviceOSTypes"`
 }
 
+// DEPRECATED: ConfigTemplateService is deprecated, use
+// ConfigurationTemplateService instead.
 type ConfigTemplateService struct {
 	BaseService
 }
@@ -6,6 +6,8 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/client"
@@ -37,39 +39,145 @@ func NewConfigurationTemplateService(c client.Client) *ConfigurationTemplateServ
 	return &ConfigurationTemplateService{BaseService: NewBaseService(c)}
 }
 
+func NewConfigurationTemplate(name string) ConfigurationTemplate {
+	logging.Trace()
+	return ConfigurationTemplate{
+		Name:          name,
+		Variables:     map[string]interface{}{},
+		DeviceOsTypes: []string{},
+	}
+}
+
+// GetAll calls `POST /configuration_manager/templates/search` and returns all
+// configuration templates configured on the server
 func (svc *ConfigurationTemplateService) GetAll() ([]ConfigurationTemplate, error) {
 	logging.Trace()
-	// FIXME (privateip) need to implement full paging
-	body := map[string]interface{}{
-		"name": "",
-		"options": map[string]interface{}{
-			"limit": 100,
-			"sort": map[string]interface{}{
-				"name": 1,
+
+	var templates []ConfigurationTemplate
+	var limit = 100
+	var start = 0
+
+	for {
+		body := map[string]interface{}{
+			"name": "",
+			"options": map[string]interface{}{
+				"limit": limit,
+				"sort": map[string]interface{}{
+					"name": 1,
+				},
+				"start": start,
 			},
-			"start": 0,
-		},
+		}
+
+		var res ConfigurationTemplateCollection
+
+		if err := svc.PostRequest(&Request{
+			uri:                "/configuration_manager/templates/search",
+			body:               &body,
+			expectedStatusCode: http.StatusOK,
+		}, &res); err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, res.List...)
+
+		if len(res.List) == 0 || len(templates) >= res.Total {
+			break
+		}
+
+		start += limit
 	}
-	var res ConfigurationTemplateCollection
-	var uri = "/configuration_manager/templates/search"
-	if err := svc.Post(uri, &body, &res); err != nil {
+
+	return templates, nil
+}
+
+// Get returns the configuration template with the specified id.  If the
+// template does not exist, this function will return an error.
+func (svc *ConfigurationTemplateService) Get(id string) (*ConfigurationTemplate, error) {
+	logging.Trace()
+
+	templates, err := svc.GetAll()
+	if err != nil {
 		return nil, err
 	}
-	return res.List, nil
+
+	for _, ele := range templates {
+		if ele.Id == id {
+			return &ele, nil
+		}
+	}
+
+	return nil, errors.New("configuration template not found")
 }
 
-func (svc *ConfigurationTemplateService) Get(id string) (*ConfigurationTemplate, error) {
+// Create calls `POST /configuration_manager/templates` to create a new
+// configuration template
+func (svc *ConfigurationTemplateService) Create(in ConfigurationTemplate) (*ConfigurationTemplate, error) {
+	logging.Trace()
+
+	body := configurationTemplateBody(in)
+
+	type Response struct {
+		Result string                 `json:"result"`
+		Data   *ConfigurationTemplate `json:"data"`
+	}
+
+	var res Response
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/configuration_manager/templates",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Data, nil
+}
+
+// Update calls `PUT /configuration_manager/templates/{id}` to replace the
+// contents of an existing configuration template
+func (svc *ConfigurationTemplateService) Update(id string, in ConfigurationTemplate) (*ConfigurationTemplate, error) {
 	logging.Trace()
-	body := map[string]interface{}{
-		"name":    id,
-		"options": make(map[string]interface{}),
+
+	body := configurationTemplateBody(in)
+
+	type Response struct {
+		Result string                 `json:"result"`
+		Data   *ConfigurationTemplate `json:"data"`
 	}
-	var res ConfigurationTemplateCollection
-	var uri = "/configuration_manager/templates/search"
-	if err := svc.Post(uri, &body, &res); err != nil {
+
+	var res Response
+
+	if err := svc.PutRequest(&Request{
+		uri:  fmt.Sprintf("/configuration_manager/templates/%s", id),
+		body: &body,
+	}, &res); err != nil {
 		return nil, err
 	}
-	return &res.List[0], nil
+
+	return res.Data, nil
+}
+
+// Delete calls `DELETE /configuration_manager/templates/{id}` to remove the
+// configuration template from the server
+func (svc *ConfigurationTemplateService) Delete(id string) error {
+	logging.Trace()
+	return svc.BaseService.Delete(fmt.Sprintf("/configuration_manager/templates/%s", id))
+}
+
+// Import creates a new configuration template from a previously exported
+// template.  Server managed fields such as the id and timestamps are ignored.
+func (svc *ConfigurationTemplateService) Import(in ConfigurationTemplate) (*ConfigurationTemplate, error) {
+	logging.Trace()
+	return svc.Create(in)
+}
+
+// Export returns the configuration template with the specified id in a form
+// that can be passed to Import
+func (svc *ConfigurationTemplateService) Export(id string) (*ConfigurationTemplate, error) {
+	logging.Trace()
+	return svc.Get(id)
 }
 
 // GetByName retrieves a configuration template by name using client-side filtering.
@@ -90,3 +198,24 @@ func (svc *ConfigurationTemplateService) GetByName(name string) (*ConfigurationT
 
 	return nil, errors.New("configuration template not found")
 }
+
+// configurationTemplateBody returns the request body used to create or update
+// a configuration template
+func configurationTemplateBody(in ConfigurationTemplate) map[string]interface{} {
+	variables := in.Variables
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	osTypes := in.DeviceOsTypes
+	if osTypes == nil {
+		osTypes = []string{}
+	}
+
+	return map[string]interface{}{
+		"name":          in.Name,
+		"template":      in.Template,
+		"variables":     variables,
+		"deviceOSTypes": osTypes,
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ ConfigurationTemplateServicer = (*ConfigurationTemplateService)(nil)
+
+func setupConfigurationTemplateService() *ConfigurationTemplateService {
+	return NewConfigurationTemplateService(
+		testlib.Setup(),
+	)
+}
+
+func TestConfigurationTemplateService_GetAllPaging(t *testing.T) {
+	svc := setupConfigurationTemplateService()
+	defer testlib.Teardown()
+
+	var starts []int
+
+	testlib.AddHandlerToMux("/configuration_manager/templates/search", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Options struct {
+				Start int `json:"start"`
+			} `json:"options"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		starts = append(starts, body.Options.Start)
+
+		var list []ConfigurationTemplate
+		count := 100
+		if body.Options.Start > 0 {
+			count = 5
+		}
+		for i := 0; i < count; i++ {
+			list = append(list, ConfigurationTemplate{Id: fmt.Sprintf("%d", body.Options.Start+i)})
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ConfigurationTemplateCollection{List: list, Total: 105})
+	})
+
+	res, err := svc.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 105, len(res))
+	assert.Equal(t, []int{0, 100}, starts)
+}
+
+func TestConfigurationTemplateService_Get(t *testing.T) {
+	svc := setupConfigurationTemplateService()
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux(
+		"/configuration_manager/templates/search",
+		`{"list": [{"id": "abc123", "name": "IOS Base", "template": "hostname {{ hostname }}"}], "total": 1}`,
+		200,
+	)
+
+	res, err := svc.Get("abc123")
+	assert.Nil(t, err)
+	assert.Equal(t, "IOS Base", res.Name)
+
+	res, err = svc.Get("missing")
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestConfigurationTemplateService_Create(t *testing.T) {
+	svc := setupConfigurationTemplateService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/templates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"result": "success", "data": {"id": "abc123", "name": "IOS Base"}}`))
+	})
+
+	res, err := svc.Create(NewConfigurationTemplate("IOS Base"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "abc123", res.Id)
+	assert.Equal(t, "IOS Base", body["name"])
+	assert.Equal(t, map[string]interface{}{}, body["variables"])
+	assert.Equal(t, []interface{}{}, body["deviceOSTypes"])
+}
+
+func TestConfigurationTemplateService_Update(t *testing.T) {
+	svc := setupConfigurationTemplateService()
+	defer testlib.Teardown()
+
+	testlib.AddPutResponseToMux(
+		"/configuration_manager/templates/abc123",
+		`{"result": "success", "data": {"id": "abc123", "name": "IOS Base", "template": "hostname foo"}}`,
+		0,
+	)
+
+	res, err := svc.Update("abc123", ConfigurationTemplate{Name: "IOS Base", Template: "hostname foo"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "hostname foo", res.Template)
+}
+
+func TestConfigurationTemplateService_Delete(t *testing.T) {
+	svc := setupConfigurationTemplateService()
+	defer testlib.Teardown()
+
+	testlib.AddDeleteResponseToMux("/configuration_manager/templates/abc123", `{"result": "success"}`, 0)
+
+	assert.Nil(t, svc.Delete("abc123"))
+}
@@ -136,3 +136,31 @@ func (svc *DeviceGroupService) Delete(id string) error {
 		expectedStatusCode: http.StatusOK,
 	}, &res)
 }
+
+// AddDevices calls `POST /configuration_manager/devicegroups/{id}/devices` to
+// add one or more devices to the device group
+func (svc *DeviceGroupService) AddDevices(id string, devices []string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{"devices": devices}
+
+	return svc.PostRequest(&Request{
+		uri:                fmt.Sprintf("/configuration_manager/devicegroups/%s/devices", id),
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
+
+// RemoveDevices calls `DELETE /configuration_manager/devicegroups/{id}/devices`
+// to remove one or more devices from the device group
+func (svc *DeviceGroupService) RemoveDevices(id string, devices []string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{"devices": devices}
+
+	return svc.DeleteRequest(&Request{
+		uri:                fmt.Sprintf("/configuration_manager/devicegroups/%s/devices", id),
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupDeviceGroupService() *DeviceGroupService {
+	return NewDeviceGroupService(
+		testlib.Setup(),
+	)
+}
+
+func TestDeviceGroupService_AddDevices(t *testing.T) {
+	svc := setupDeviceGroupService()
+	defer testlib.Teardown()
+
+	var body map[string][]string
+
+	testlib.AddHandlerToMux("/configuration_manager/devicegroups/abc123/devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "success"}`))
+	})
+
+	err := svc.AddDevices("abc123", []string{"rtr1", "rtr2"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"rtr1", "rtr2"}, body["devices"])
+}
+
+func TestDeviceGroupService_RemoveDevices(t *testing.T) {
+	svc := setupDeviceGroupService()
+	defer testlib.Teardown()
+
+	var body map[string][]string
+
+	testlib.AddHandlerToMux("/configuration_manager/devicegroups/abc123/devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "success"}`))
+	})
+
+	err := svc.RemoveDevices("abc123", []string{"rtr2"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"rtr2"}, body["devices"])
+}
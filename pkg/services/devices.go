@@ -6,7 +6,9 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 
 	"github.com/itential/ipctl/internal/logging"
@@ -24,6 +26,14 @@ type Device struct {
 	Properties map[string]interface{} `json:"properties"`
 }
 
+// DeviceConfiguration represents the current configuration of a device as
+// returned by the Configuration Manager
+type DeviceConfiguration struct {
+	Id     string `json:"id"`
+	Device string `json:"device"`
+	Config string `json:"config"`
+}
+
 // DeviceService provides methods for managing devices
 type DeviceService struct {
 	BaseService
@@ -79,7 +89,6 @@ func (svc *DeviceService) GetAll() ([]Device, error) {
 	var devices []Device
 
 	var limit = 100
-	var skip = 0
 	var start = 0
 
 	type Response struct {
@@ -125,11 +134,11 @@ func (svc *DeviceService) GetAll() ([]Device, error) {
 			devices = append(devices, d)
 		}
 
-		if len(devices) == res.Total {
+		if len(devices) >= res.Total || len(res.List) == 0 {
 			break
 		}
 
-		skip += limit
+		start += limit
 	}
 
 	return devices, nil
@@ -183,3 +192,69 @@ func (svc *DeviceService) Get(name string) (*Device, error) {
 	return &device, nil
 
 }
+
+// GetConfiguration calls `GET /configuration_manager/devices/{name}/configuration`
+// and returns the current configuration for the device
+func (svc *DeviceService) GetConfiguration(name string) (*DeviceConfiguration, error) {
+	logging.Trace()
+
+	var res *DeviceConfiguration
+	var uri = fmt.Sprintf("/configuration_manager/devices/%s/configuration", url.PathEscape(name))
+
+	if err := svc.BaseService.Get(uri, &res); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errors.New("device configuration not found")
+	}
+
+	if res.Device == "" {
+		res.Device = name
+	}
+
+	return res, nil
+}
+
+// Create calls `POST /configuration_manager/adapters/{adapter}/devices` to
+// create a new device using the specified adapter.  Any device properties are
+// sent along with the name, host and ostype of the device.
+func (svc *DeviceService) Create(adapter string, in Device) (*Device, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{}
+	for key, value := range in.Properties {
+		body[key] = value
+	}
+	body["name"] = in.Name
+	body["host"] = in.Host
+	body["ostype"] = in.OsType
+
+	var res map[string]interface{}
+
+	if err := svc.PostRequest(&Request{
+		uri:                fmt.Sprintf("/configuration_manager/adapters/%s/devices", url.PathEscape(adapter)),
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	device := in
+	if res != nil {
+		if err := svc.unmarshal(res, &device); err != nil {
+			return nil, err
+		}
+	}
+
+	return &device, nil
+}
+
+// Delete calls `DELETE /configuration_manager/adapters/{adapter}/devices/{name}`
+func (svc *DeviceService) Delete(adapter, name string) error {
+	logging.Trace()
+	return svc.BaseService.Delete(fmt.Sprintf(
+		"/configuration_manager/adapters/%s/devices/%s",
+		url.PathEscape(adapter), url.PathEscape(name),
+	))
+}
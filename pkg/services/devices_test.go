@@ -5,6 +5,9 @@
 package services
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/itential/ipctl/internal/testlib"
@@ -42,6 +45,40 @@ func TestDeviceService_GetAll(t *testing.T) {
 	}
 }
 
+func TestDeviceService_GetAllPaging(t *testing.T) {
+	svc := setupDeviceService()
+	defer testlib.Teardown()
+
+	var starts []int
+
+	testlib.AddHandlerToMux("/configuration_manager/devices", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Options struct {
+				Start int `json:"start"`
+				Limit int `json:"limit"`
+			} `json:"options"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		starts = append(starts, body.Options.Start)
+
+		var list []map[string]interface{}
+		for i := body.Options.Start; i < 150 && i < body.Options.Start+body.Options.Limit; i++ {
+			list = append(list, map[string]interface{}{"name": fmt.Sprintf("device-%v", i)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": 150, "list": list})
+	})
+
+	res, err := svc.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 150, len(res))
+	assert.Equal(t, []int{0, 100}, starts)
+	assert.Equal(t, "device-149", res[149].Name)
+}
+
 func TestDeviceService_Get(t *testing.T) {
 	svc := setupDeviceService()
 	defer testlib.Teardown()
@@ -103,3 +140,68 @@ func TestDeviceService_unmarshal(t *testing.T) {
 	assert.Equal(t, "value", device.Properties["custom_property"])
 	assert.Equal(t, 123, device.Properties["another_field"])
 }
+
+func TestDeviceService_GetConfiguration(t *testing.T) {
+	svc := setupDeviceService()
+	defer testlib.Teardown()
+
+	mockResponse := `{"id":"test-device","config":"hostname test-device\ninterface lo0\n"}`
+
+	testlib.AddGetResponseToMux("/configuration_manager/devices/test-device/configuration", mockResponse, 0)
+
+	res, err := svc.GetConfiguration("test-device")
+
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "test-device", res.Device)
+	assert.Equal(t, "hostname test-device\ninterface lo0\n", res.Config)
+}
+
+func TestDeviceService_GetConfiguration_Error(t *testing.T) {
+	svc := setupDeviceService()
+	defer testlib.Teardown()
+
+	testlib.AddGetErrorToMux("/configuration_manager/devices/missing/configuration", `{"message":"not found"}`, 0)
+
+	res, err := svc.GetConfiguration("missing")
+
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
+func TestDeviceService_Create(t *testing.T) {
+	svc := setupDeviceService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/adapters/nso/devices", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "rtr1", "host": "10.0.0.1", "ostype": "cisco-ios"}`))
+	})
+
+	res, err := svc.Create("nso", Device{
+		Name:       "rtr1",
+		Host:       "10.0.0.1",
+		OsType:     "cisco-ios",
+		Properties: map[string]interface{}{"credentials": "lab"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "rtr1", res.Name)
+	assert.Equal(t, "10.0.0.1", body["host"])
+	assert.Equal(t, "lab", body["credentials"])
+}
+
+func TestDeviceService_Delete(t *testing.T) {
+	svc := setupDeviceService()
+	defer testlib.Teardown()
+
+	testlib.AddDeleteResponseToMux("/configuration_manager/adapters/nso/devices/rtr1", "", 0)
+
+	err := svc.Delete("nso", "rtr1")
+
+	assert.Nil(t, err)
+}
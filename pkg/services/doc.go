@@ -98,6 +98,7 @@
 //   - TemplateService: Manage templates
 //   - CommandTemplateService: Manage command templates
 //   - AnalyticTemplateService: Manage analytic templates
+//   - PermissionService: Manage the read and write groups of assets
 //
 // Operations Manager:
 //   - AutomationService: Manage automations and orchestrations
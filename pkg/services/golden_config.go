@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/itential/ipctl/internal/logging"
 	"github.com/itential/ipctl/pkg/client"
@@ -173,3 +174,84 @@ func (svc *GoldenConfigService) Export(id string) (*GoldenConfigTree, error) {
 
 	return &res.Data[0], nil
 }
+
+// nodeUri returns the URI for a node in a golden configuration tree version.
+// The node path is escaped so nested paths such as `base/ntp` are sent as a
+// single path element.
+func nodeUri(treeId, version, nodePath string) string {
+	return fmt.Sprintf(
+		"/configuration_manager/configs/%s/%s/%s",
+		treeId, url.PathEscape(version), url.PathEscape(nodePath),
+	)
+}
+
+// GetVersion calls `GET /configuration_manager/configs/{id}/{version}`
+func (svc *GoldenConfigService) GetVersion(treeId, version string) (*GoldenConfigTree, error) {
+	logging.Trace()
+
+	var res *GoldenConfigTree
+	var uri = fmt.Sprintf("/configuration_manager/configs/%s/%s", treeId, url.PathEscape(version))
+
+	if err := svc.BaseService.Get(uri, &res); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errors.New("gctree version not found")
+	}
+
+	return res, nil
+}
+
+// AddNode calls `POST /configuration_manager/configs/{id}/{version}/{parentPath}`
+// to add a child node with the specified name to the parent node.
+func (svc *GoldenConfigService) AddNode(treeId, version, parentPath, name string) (map[string]interface{}, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{"name": name}
+
+	var res map[string]interface{}
+
+	if err := svc.PostRequest(&Request{
+		uri:                nodeUri(treeId, version, parentPath),
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteNode calls `DELETE /configuration_manager/configs/{id}/{version}/{nodePath}`
+func (svc *GoldenConfigService) DeleteNode(treeId, version, nodePath string) error {
+	logging.Trace()
+	return svc.BaseService.Delete(nodeUri(treeId, version, nodePath))
+}
+
+// UpdateNodeVariables calls `PUT /configuration_manager/configs/{id}/{version}/{nodePath}`
+// to replace the variables defined for the node.
+func (svc *GoldenConfigService) UpdateNodeVariables(treeId, version, nodePath string, vars map[string]interface{}) error {
+	logging.Trace()
+
+	body := map[string]interface{}{"variables": vars}
+
+	return svc.PutRequest(&Request{
+		uri:  nodeUri(treeId, version, nodePath),
+		body: &body,
+	}, nil)
+}
+
+// AddDevicesToNode calls `POST /configuration_manager/configs/{id}/{version}/{nodePath}/devices`
+// to attach one or more devices to the node.
+func (svc *GoldenConfigService) AddDevicesToNode(treeId, version, nodePath string, devices []string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{"devices": devices}
+
+	return svc.PostRequest(&Request{
+		uri:                nodeUri(treeId, version, nodePath) + "/devices",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoldenConfigService_GetVersion(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux(
+		"/configuration_manager/configs/tree1/initial",
+		`{"_id": "v1", "name": "ios", "version": "initial", "root": {"id": "n1", "name": "base", "children": []}}`,
+		0,
+	)
+
+	res, err := svc.GetVersion("tree1", "initial")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "initial", res.Version)
+	assert.Equal(t, "base", res.Root["name"])
+}
+
+func TestGoldenConfigService_AddNode(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/configs/tree1/initial/base", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "n2", "name": "ntp"}`))
+	})
+
+	res, err := svc.AddNode("tree1", "initial", "base", "ntp")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "n2", res["id"])
+	assert.Equal(t, "ntp", body["name"])
+}
+
+func TestGoldenConfigService_UpdateNodeVariables(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	var method string
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("/configuration_manager/configs/tree1/initial/base", func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	err := svc.UpdateNodeVariables("tree1", "initial", "base", map[string]interface{}{"ntp": "10.0.0.1"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, map[string]interface{}{"ntp": "10.0.0.1"}, body["variables"])
+}
+
+func TestGoldenConfigService_AddDevicesToNode(t *testing.T) {
+	svc := setupGoldenConfigService()
+	defer testlib.Teardown()
+
+	testlib.AddPostResponseToMux("/configuration_manager/configs/tree1/initial/base/devices", `{}`, http.StatusOK)
+
+	err := svc.AddDevicesToNode("tree1", "initial", "base", []string{"rtr1"})
+
+	assert.Nil(t, err)
+}
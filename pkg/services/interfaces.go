@@ -116,9 +116,9 @@ type DeviceGroupServicer interface {
 // It provides CRUD operations for device inventory management.
 type DeviceServicer interface {
 	GetAll() ([]Device, error)
-	Get(id string) (*Device, error)
-	Create(in Device) (*Device, error)
-	Delete(id string) error
+	Get(name string) (*Device, error)
+	Create(adapter string, in Device) (*Device, error)
+	Delete(adapter, name string) error
 }
 
 // GoldenConfigServicer defines operations for managing golden configurations.
@@ -180,6 +180,7 @@ type JsonFormServicer interface {
 	GetAll() ([]JsonForm, error)
 	Get(id string) (*JsonForm, error)
 	Create(in JsonForm) (*JsonForm, error)
+	Update(in JsonForm) (*JsonForm, error)
 	Delete(ids []string) error
 	Import(in JsonForm) (*JsonForm, error)
 }
@@ -251,6 +252,7 @@ type TemplateServicer interface {
 	GetAll() ([]Template, error)
 	Get(id string) (*Template, error)
 	Create(in Template) (*Template, error)
+	Update(in Template) (*Template, error)
 	Delete(id string) error
 	Import(in Template) (*Template, error)
 	Export(id string) (*Template, error)
@@ -262,6 +264,7 @@ type TransformationServicer interface {
 	GetAll() ([]Transformation, error)
 	Get(id string) (*Transformation, error)
 	Create(in Transformation) (*Transformation, error)
+	Update(in Transformation) (*Transformation, error)
 	Delete(id string) error
 	Import(in Transformation) (*Transformation, error)
 }
@@ -143,6 +143,35 @@ func (svc *JsonFormService) Create(in JsonForm) (*JsonForm, error) {
 	return res.Doc, nil
 }
 
+// Update replaces an existing JSON Form on the server.  The form must have a
+// valid Id field.  Returns the updated form or an error if the server reports
+// a failure.
+func (svc *JsonFormService) Update(in JsonForm) (*JsonForm, error) {
+	logging.Trace()
+
+	type Response struct {
+		Status  string    `json:"status"`
+		Doc     *JsonForm `json:"doc"`
+		Message string    `json:"message"`
+	}
+
+	var res Response
+
+	if err := svc.PutRequest(&Request{
+		uri:                fmt.Sprintf("/json-forms/forms/%s", in.Id),
+		body:               &in,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Status == "failure" {
+		return nil, errors.New(res.Message)
+	}
+
+	return res.Doc, nil
+}
+
 // Delete removes one or more JSON Form assets from the server by their IDs.
 // This is a bulk operation that can delete multiple forms in a single request.
 // Returns an error if any of the deletions fail.
@@ -249,3 +249,41 @@ func TestJsonFormImport(t *testing.T) {
 		assert.Equal(t, "test", res.Name) // The mocked response returns "test" as name
 	}
 }
+
+func TestJsonFormUpdate(t *testing.T) {
+	svc := setupJsonFormService()
+	defer testlib.Teardown()
+
+	testlib.AddPutResponseToMux(
+		"/json-forms/forms/form1",
+		`{"status": "success", "doc": {"id": "form1", "name": "renamed"}}`,
+		http.StatusOK,
+	)
+
+	in := NewJsonForm("renamed", "")
+	in.Id = "form1"
+
+	res, err := svc.Update(in)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", res.Name)
+}
+
+func TestJsonFormUpdateFailure(t *testing.T) {
+	svc := setupJsonFormService()
+	defer testlib.Teardown()
+
+	testlib.AddPutResponseToMux(
+		"/json-forms/forms/form1",
+		`{"status": "failure", "message": "name already exists"}`,
+		http.StatusOK,
+	)
+
+	in := NewJsonForm("renamed", "")
+	in.Id = "form1"
+
+	res, err := svc.Update(in)
+
+	assert.EqualError(t, err, "name already exists")
+	assert.Nil(t, res)
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/itential/ipctl/internal/logging"
+	"github.com/itential/ipctl/pkg/client"
+)
+
+// AssetPermissions holds the read and write groups of an Automation Studio
+// asset.  The groups are only read and written by the PermissionService so
+// they are never included when assets are exported, imported or copied
+// between servers where the group ids are not valid.
+type AssetPermissions struct {
+	Id   string
+	Name string
+	Gbac Gbac
+
+	// document is the asset as returned by the server.  It is sent back
+	// with the new groups when the permissions are updated so no field of
+	// the asset is lost.
+	document map[string]interface{}
+}
+
+// permissionEndpoint describes the API used to read and update the
+// permissions of an asset kind.
+type permissionEndpoint struct {
+	uri     string // returns the list of assets
+	results string // field of the paginated response with the assets
+	update  string // format string used to build the update uri
+	id      string // field of the asset document with the id
+	wrap    bool   // the update body is sent as `{"update": <asset>}`
+}
+
+var permissionEndpoints = map[string]permissionEndpoint{
+	"workflow": {
+		uri:     "/automation-studio/workflows",
+		results: "items",
+		update:  "/automation-studio/automations/%s",
+		id:      "_id",
+		wrap:    true,
+	},
+	"template": {
+		uri:     "/automation-studio/templates",
+		results: "items",
+		update:  "/automation-studio/templates/%s",
+		id:      "_id",
+		wrap:    true,
+	},
+	"transformation": {
+		uri:     "/transformations",
+		results: "results",
+		update:  "/transformations/%s",
+		id:      "_id",
+	},
+	"jsonform": {
+		uri:    "/json-forms/forms",
+		update: "/json-forms/forms/%s",
+		id:     "id",
+	},
+}
+
+// PermissionService reads and updates the read and write groups of
+// workflows, templates, transformations and JSON forms.
+type PermissionService struct {
+	BaseService
+}
+
+// NewPermissionService creates and returns a new PermissionService instance
+// configured with the provided client for API communication.
+func NewPermissionService(c client.Client) *PermissionService {
+	return &PermissionService{BaseService: NewBaseService(c)}
+}
+
+// GetAll returns the permissions of every asset of the kind.  The kind must
+// be one of `workflow`, `template`, `transformation` or `jsonform`.
+func (svc *PermissionService) GetAll(kind string) ([]AssetPermissions, error) {
+	logging.Trace()
+
+	endpoint, exists := permissionEndpoints[kind]
+	if !exists {
+		return nil, fmt.Errorf("unsupported asset type `%s`", kind)
+	}
+
+	var documents []map[string]interface{}
+
+	if endpoint.results == "" {
+		if err := svc.BaseService.Get(endpoint.uri, &documents); err != nil {
+			return nil, err
+		}
+	} else {
+		var limit = 100
+		var skip = 0
+
+		for {
+			var res map[string]json.RawMessage
+			if err := svc.GetRequest(&Request{
+				uri:    endpoint.uri,
+				params: &QueryParams{Limit: limit, Skip: skip},
+			}, &res); err != nil {
+				return nil, err
+			}
+
+			var items []map[string]interface{}
+			if err := json.Unmarshal(res[endpoint.results], &items); err != nil {
+				return nil, err
+			}
+
+			var total int
+			if err := json.Unmarshal(res["total"], &total); err != nil {
+				return nil, err
+			}
+
+			documents = append(documents, items...)
+
+			if len(items) == 0 || len(documents) >= total {
+				break
+			}
+
+			skip += limit
+		}
+	}
+
+	var assets []AssetPermissions
+
+	for _, ele := range documents {
+		asset := AssetPermissions{
+			Gbac:     Gbac{Read: []string{}, Write: []string{}},
+			document: ele,
+		}
+
+		asset.Id, _ = ele[endpoint.id].(string)
+		asset.Name, _ = ele["name"].(string)
+
+		if value, exists := ele["gbac"]; exists && value != nil {
+			if err := Unmarshal(value, &asset.Gbac); err != nil {
+				return nil, err
+			}
+			if asset.Gbac.Read == nil {
+				asset.Gbac.Read = []string{}
+			}
+			if asset.Gbac.Write == nil {
+				asset.Gbac.Write = []string{}
+			}
+		}
+
+		assets = append(assets, asset)
+	}
+
+	logging.Info("Found %v %s(s)", len(assets), kind)
+
+	return assets, nil
+}
+
+// Update replaces the read and write groups of the asset with the groups in
+// in.Gbac.  The asset must have been returned by GetAll.
+func (svc *PermissionService) Update(kind string, in AssetPermissions) error {
+	logging.Trace()
+
+	endpoint, exists := permissionEndpoints[kind]
+	if !exists {
+		return fmt.Errorf("unsupported asset type `%s`", kind)
+	}
+
+	document := map[string]interface{}{}
+	for key, value := range in.document {
+		document[key] = value
+	}
+	document["gbac"] = in.Gbac
+
+	var body any = document
+	if endpoint.wrap {
+		body = map[string]interface{}{"update": document}
+	}
+
+	var res any
+
+	if err := svc.PutRequest(&Request{
+		uri:                fmt.Sprintf(endpoint.update, in.Id),
+		body:               body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return err
+	}
+
+	// JSON forms report a failed update in the body of the response
+	if m, ok := res.(map[string]interface{}); ok && m["status"] == "failure" {
+		return errors.New(fmt.Sprint(m["message"]))
+	}
+
+	return nil
+}
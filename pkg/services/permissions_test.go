@@ -0,0 +1,74 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionGetAll(t *testing.T) {
+	svc := NewPermissionService(testlib.Setup())
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/transformations", `{
+		"results": [
+			{"_id": "t1", "name": "hostname", "gbac": {"read": ["g1"], "write": ["g2"]}},
+			{"_id": "t2", "name": "site"}
+		],
+		"total": 2
+	}`, 0)
+
+	testlib.AddGetResponseToMux("/json-forms/forms", `[{"id": "f1", "name": "form", "gbac": {"read": null, "write": ["g2"]}}]`, 0)
+
+	res, err := svc.GetAll("transformation")
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, "t1", res[0].Id)
+	assert.Equal(t, Gbac{Read: []string{"g1"}, Write: []string{"g2"}}, res[0].Gbac)
+	assert.Equal(t, Gbac{Read: []string{}, Write: []string{}}, res[1].Gbac)
+
+	res, err = svc.GetAll("jsonform")
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "f1", res[0].Id)
+	assert.Equal(t, Gbac{Read: []string{}, Write: []string{"g2"}}, res[0].Gbac)
+
+	_, err = svc.GetAll("automation")
+	assert.EqualError(t, err, "unsupported asset type `automation`")
+}
+
+func TestPermissionUpdate(t *testing.T) {
+	svc := NewPermissionService(testlib.Setup())
+	defer testlib.Teardown()
+
+	testlib.AddGetResponseToMux("/json-forms/forms", `[{"id": "f1", "name": "form", "schema": {"type": "object"}}]`, 0)
+
+	var body map[string]interface{}
+	testlib.AddHandlerToMux("PUT /json-forms/forms/{id}", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"status": "failure", "message": "form is locked"}`))
+	})
+
+	res, err := svc.GetAll("jsonform")
+	require.NoError(t, err)
+
+	res[0].Gbac = Gbac{Read: []string{"g1"}, Write: []string{"g1"}}
+
+	err = svc.Update("jsonform", res[0])
+	assert.EqualError(t, err, "form is locked")
+
+	// the whole document is sent back with the new groups
+	assert.Equal(t, map[string]interface{}{"type": "object"}, body["schema"])
+	assert.Equal(t, map[string]interface{}{
+		"read":  []interface{}{"g1"},
+		"write": []interface{}{"g1"},
+	}, body["gbac"])
+}
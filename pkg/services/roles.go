@@ -106,6 +106,55 @@ func (svc *RoleService) Create(in Role) (*Role, error) {
 	return response.Data, nil
 }
 
+// Update replaces the description, allowed methods and allowed views of an
+// existing role.  The role must have a valid Id field.  The name and
+// provenance of a role can not be changed.
+// It sends a PATCH request to /authorization/roles/{id}.
+// Returns the updated role or an error if the operation fails.
+func (svc *RoleService) Update(in Role) (*Role, error) {
+	logging.Trace()
+
+	properties := map[string]interface{}{
+		"description":    in.Description,
+		"allowedMethods": []any{},
+		"allowedViews":   []any{},
+	}
+
+	if len(in.AllowedMethods) > 0 {
+		properties["allowedMethods"] = in.AllowedMethods
+	}
+
+	if len(in.AllowedViews) > 0 {
+		properties["allowedViews"] = in.AllowedViews
+	}
+
+	resp, err := Do(&Request{
+		client:             svc.client,
+		method:             http.MethodPatch,
+		uri:                fmt.Sprintf("/authorization/roles/%s", in.Id),
+		body:               map[string]interface{}{"properties": properties},
+		expectedStatusCode: http.StatusOK,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type Response struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+
+	var response Response
+
+	if err := json.Unmarshal(resp.Body, &response); err != nil {
+		return nil, err
+	}
+
+	logging.Info("%s", response.Message)
+
+	return &in, nil
+}
+
 // Delete removes a role from the authorization system by its ID.
 // It sends a DELETE request to /authorization/roles/{id}.
 // Returns an error if the operation fails or the role is not found.
@@ -5,6 +5,7 @@
 package services
 
 import (
+	"encoding/json"
 	"net/http"
 	"path/filepath"
 	"reflect"
@@ -226,6 +227,52 @@ func TestRoleServiceDeleteError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+// TestRoleServiceUpdate tests updating an existing role
+func TestRoleServiceUpdate(t *testing.T) {
+	svc := setupRoleService()
+	defer testlib.Teardown()
+
+	var body map[string]interface{}
+
+	testlib.AddHandlerToMux("PATCH /authorization/roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "678ec1ab6d849669c6dbe952", r.PathValue("id"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"status": "OK", "message": "Role updated"}`))
+	})
+
+	res, err := svc.Update(Role{
+		Id:          "678ec1ab6d849669c6dbe952",
+		Name:        "operator",
+		Provenance:  "Custom",
+		Description: "Network operators",
+		AllowedMethods: []RoleMethod{
+			{Name: "getWorkflows", Provenance: "AutomationStudio"},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "operator", res.Name)
+
+	properties := body["properties"].(map[string]interface{})
+	assert.Equal(t, "Network operators", properties["description"])
+	assert.Len(t, properties["allowedMethods"], 1)
+	assert.Equal(t, []interface{}{}, properties["allowedViews"])
+	assert.NotContains(t, properties, "name")
+}
+
+// TestRoleServiceUpdateError tests error handling for Update
+func TestRoleServiceUpdateError(t *testing.T) {
+	svc := setupRoleService()
+	defer testlib.Teardown()
+
+	testlib.AddPatchErrorToMux("/authorization/roles/{id}", "", http.StatusNotFound)
+
+	res, err := svc.Update(Role{Id: "invalid-id"})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}
+
 // TestRoleServiceImport tests importing a role
 func TestRoleServiceImport(t *testing.T) {
 	svc := setupRoleService()
@@ -18,6 +18,13 @@ type Tag struct {
 	Description string `json:"description"`
 }
 
+// TagReference attaches a tag to an asset.
+type TagReference struct {
+	TagId string `json:"tag_id"`
+	RefId string `json:"ref_id"`
+	Type  string `json:"type"`
+}
+
 type TagService struct {
 	BaseService
 }
@@ -109,6 +116,28 @@ func (svc *TagService) GetTagsForReference(id string) ([]Tag, error) {
 	return res, nil
 }
 
+// GetReferencesForTag implements `POST /tags/getTagReferences` and returns
+// every asset reference attached to the tag.
+func (svc *TagService) GetReferencesForTag(tagId string) ([]TagReference, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"data": map[string]string{"tag_id": tagId},
+	}
+
+	var res []TagReference
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/tags/getTagReferences",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (svc *TagService) Create(in Tag) (*Tag, error) {
 	logging.Trace()
 
@@ -139,3 +168,42 @@ func (svc *TagService) Delete(id string) error {
 	}, nil)
 
 }
+
+// CreateReference implements `POST /tags/createReference` and attaches the
+// tag to the asset identified by refId.
+func (svc *TagService) CreateReference(tagId, refId, refType string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"data": map[string]string{
+			"tag_id": tagId,
+			"ref_id": refId,
+			"type":   refType,
+		},
+	}
+
+	return svc.PostRequest(&Request{
+		uri:                "/tags/createReference",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
+
+// DeleteReference implements `POST /tags/deleteReference` and detaches the
+// tag from the asset identified by refId.
+func (svc *TagService) DeleteReference(tagId, refId string) error {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"data": map[string]string{
+			"tag_id": tagId,
+			"ref_id": refId,
+		},
+	}
+
+	return svc.PostRequest(&Request{
+		uri:                "/tags/deleteReference",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, nil)
+}
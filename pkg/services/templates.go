@@ -169,6 +169,28 @@ func (svc *TemplateService) Create(in Template) (*Template, error) {
 	return res.Template, nil
 }
 
+// Update replaces an existing template on the server.  The template must
+// have a valid Id field.
+func (svc *TemplateService) Update(in Template) (*Template, error) {
+	logging.Trace()
+
+	type Response struct {
+		Template *Template `json:"updated"`
+	}
+
+	var res Response
+
+	if err := svc.PutRequest(&Request{
+		uri:                fmt.Sprintf("/automation-studio/templates/%s", in.Id),
+		body:               map[string]interface{}{"update": in},
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Template, nil
+}
+
 // Delete removes a template by its ID
 func (svc *TemplateService) Delete(id string) error {
 	logging.Trace()
@@ -219,3 +241,30 @@ func (svc *TemplateService) Export(id string) (*Template, error) {
 
 	return res, nil
 }
+
+// Parse calls `POST /template_builder/parseTemplate` to parse the text using
+// the TextFSM template and returns the structured result
+func (svc *TemplateService) Parse(template, text string) (any, error) {
+	logging.Trace()
+
+	body := map[string]interface{}{
+		"template": map[string]interface{}{
+			"name":     "ipctl",
+			"type":     "textfsm",
+			"template": template,
+			"text":     text,
+		},
+	}
+
+	var res any
+
+	if err := svc.PostRequest(&Request{
+		uri:                "/template_builder/parseTemplate",
+		body:               &body,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Itential Inc. All Rights Reserved
+// Unauthorized copying of this file, via any medium is strictly prohibited
+// Proprietary and confidential
+
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/itential/ipctl/internal/testlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTemplateService() *TemplateService {
+	return NewTemplateService(
+		testlib.Setup(),
+	)
+}
+
+func TestTemplateService_Parse(t *testing.T) {
+	svc := setupTemplateService()
+	defer testlib.Teardown()
+
+	var body struct {
+		Template map[string]string `json:"template"`
+	}
+
+	testlib.AddHandlerToMux("/template_builder/parseTemplate", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"hostname": "rtr1"}]`))
+	})
+
+	res, err := svc.Parse("Value hostname (\\S+)", "hostname rtr1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{"hostname": "rtr1"}}, res)
+	assert.Equal(t, "textfsm", body.Template["type"])
+	assert.Equal(t, "hostname rtr1", body.Template["text"])
+}
+
+func TestTemplateUpdate(t *testing.T) {
+	svc := setupTemplateService()
+	defer testlib.Teardown()
+
+	testlib.AddHandlerToMux("/automation-studio/templates/tmpl1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		var body map[string]Template
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "renamed", body["update"].Name)
+
+		w.Write([]byte(`{"updated": {"_id": "tmpl1", "name": "renamed"}}`))
+	})
+
+	in := NewTemplate("renamed", "group", "", "jinja2")
+	in.Id = "tmpl1"
+
+	res, err := svc.Update(in)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", res.Name)
+}
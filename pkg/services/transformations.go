@@ -178,6 +178,24 @@ func (svc *TransformationService) Create(in Transformation) (*Transformation, er
 	return res, nil
 }
 
+// Update will replace an existing transformation on the server.  The
+// transformation must have a valid Id field.
+func (svc *TransformationService) Update(in Transformation) (*Transformation, error) {
+	logging.Trace()
+
+	var res *Transformation
+
+	if err := svc.PutRequest(&Request{
+		uri:                fmt.Sprintf("/transformations/%s", in.Id),
+		body:               &in,
+		expectedStatusCode: http.StatusOK,
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // Delete will remove an existing transformation with the specifie ID from the
 // server.  If the specified ID does not exist, this function will still return
 // successfully.
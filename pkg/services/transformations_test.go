@@ -275,3 +275,37 @@ func TestNewTransformationHasEmptyTags(t *testing.T) {
 	tr := NewTransformation("test", "")
 	assert.Equal(t, []Tag{}, tr.Tags)
 }
+
+func TestTransformationUpdate(t *testing.T) {
+	svc := setupTransformationService()
+	defer testlib.Teardown()
+
+	testlib.AddPutResponseToMux(
+		"/transformations/jst1",
+		`{"_id": "jst1", "name": "renamed"}`,
+		http.StatusOK,
+	)
+
+	in := NewTransformation("renamed", "")
+	in.Id = "jst1"
+
+	res, err := svc.Update(in)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", res.Name)
+}
+
+func TestTransformationUpdateError(t *testing.T) {
+	svc := setupTransformationService()
+	defer testlib.Teardown()
+
+	testlib.AddPutErrorToMux("/transformations/jst1", "", 0)
+
+	in := NewTransformation("renamed", "")
+	in.Id = "jst1"
+
+	res, err := svc.Update(in)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, res)
+}